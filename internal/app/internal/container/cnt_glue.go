@@ -1,17 +1,21 @@
 package container
 
 import (
+	"github.com/fe3dback/go-arch-lint/internal/services/baseline"
 	"github.com/fe3dback/go-arch-lint/internal/services/checker"
 	"github.com/fe3dback/go-arch-lint/internal/services/common/path"
 	"github.com/fe3dback/go-arch-lint/internal/services/common/yaml/reference"
+	"github.com/fe3dback/go-arch-lint/internal/services/lsp"
 	"github.com/fe3dback/go-arch-lint/internal/services/project/holder"
 	"github.com/fe3dback/go-arch-lint/internal/services/project/info"
 	"github.com/fe3dback/go-arch-lint/internal/services/project/resolver"
 	"github.com/fe3dback/go-arch-lint/internal/services/project/scanner"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/watcher"
 	"github.com/fe3dback/go-arch-lint/internal/services/render/code"
 	"github.com/fe3dback/go-arch-lint/internal/services/schema"
 	specassembler "github.com/fe3dback/go-arch-lint/internal/services/spec/assembler"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec/decoder"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec/editor"
 	specvalidator "github.com/fe3dback/go-arch-lint/internal/services/spec/validator"
 )
 
@@ -53,6 +57,7 @@ func (c *Container) provideReferenceRender() *code.Render {
 func (c *Container) provideSpecChecker() *checker.CompositeChecker {
 	return checker.NewCompositeChecker(
 		c.provideSpecImportsChecker(),
+		c.provideSpecCyclesChecker(),
 		c.provideSpecDeepScanChecker(),
 	)
 }
@@ -63,6 +68,12 @@ func (c *Container) provideSpecImportsChecker() *checker.Imports {
 	)
 }
 
+func (c *Container) provideSpecCyclesChecker() *checker.Cycles {
+	return checker.NewCycles(
+		c.provideProjectFilesResolver(),
+	)
+}
+
 func (c *Container) provideSpecDeepScanChecker() *checker.DeepScan {
 	return checker.NewDeepScan(
 		c.provideProjectFilesResolver(),
@@ -71,16 +82,26 @@ func (c *Container) provideSpecDeepScanChecker() *checker.DeepScan {
 }
 
 func (c *Container) provideProjectFilesResolver() *resolver.Resolver {
-	return resolver.NewResolver(
+	if c.projectFilesResolver != nil {
+		return c.projectFilesResolver
+	}
+
+	c.projectFilesResolver = resolver.NewResolver(
 		c.provideProjectFilesScanner(),
 		c.provideProjectFilesHolder(),
 	)
+
+	return c.projectFilesResolver
 }
 
 func (c *Container) provideProjectFilesScanner() *scanner.Scanner {
 	return scanner.NewScanner()
 }
 
+func (c *Container) provideProjectWatcher() *watcher.Watcher {
+	return watcher.NewWatcher()
+}
+
 func (c *Container) provideProjectFilesHolder() *holder.Holder {
 	return holder.NewHolder()
 }
@@ -92,3 +113,15 @@ func (c *Container) provideProjectInfoAssembler() *info.Assembler {
 func (c *Container) provideJsonSchemaProvider() *schema.Provider {
 	return schema.NewProvider()
 }
+
+func (c *Container) provideBaselineStorage() *baseline.Baseline {
+	return baseline.NewBaseline()
+}
+
+func (c *Container) provideArchEditor() *editor.Editor {
+	return editor.NewEditor()
+}
+
+func (c *Container) provideLspServer() *lsp.Server {
+	return lsp.NewServer()
+}
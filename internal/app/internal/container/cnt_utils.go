@@ -27,5 +27,6 @@ func (c *Container) ProvideRenderer() *render.Renderer {
 		c.flags.OutputType,
 		c.flags.OutputJsonOneLine,
 		view.Templates,
+		c.version,
 	)
 }
@@ -2,6 +2,7 @@ package container
 
 import (
 	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/resolver"
 )
 
 type Container struct {
@@ -10,6 +11,10 @@ type Container struct {
 	commitHash string
 
 	flags models.FlagsRoot
+
+	// shared between all checkers, so project is scanned
+	// (and std packages is loaded) only once
+	projectFilesResolver *resolver.Resolver
 }
 
 func NewContainer(
@@ -110,14 +110,24 @@ func (c *Container) commands() []*cobra.Command {
 		unwrap(c.commandSchema()),
 		unwrap(c.commandCheck()),
 		unwrap(c.commandMapping()),
+		unwrap(c.commandExplain()),
 		unwrap(c.commandGraph()),
+		unwrap(c.commandUnused()),
+		unwrap(c.commandInit()),
+		unwrap(c.commandLsp()),
 	}
 
 	list := make([]*cobra.Command, 0, len(executors))
 	for _, x := range executors {
 		x := x
 		x.cmd.RunE = func(activeCmd *cobra.Command, _ []string) error {
-			return c.ProvideRenderer().RenderModel(x.runE(activeCmd))
+			model, err := x.runE(activeCmd)
+			if model == nil && err == nil {
+				// command already render all output by itself (watch mode)
+				return nil
+			}
+
+			return c.ProvideRenderer().RenderModel(model, err)
 		}
 		list = append(list, x.cmd)
 	}
@@ -2,6 +2,11 @@ package container
 
 import (
 	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/operations/check"
@@ -17,14 +22,27 @@ func (c *Container) commandCheck() (*cobra.Command, runner) {
 	}
 
 	in := models.CmdCheckIn{
-		ProjectPath: models.DefaultProjectPath,
-		ArchFile:    models.DefaultArchFileName,
-		MaxWarnings: 100,
+		ProjectPath:   models.DefaultProjectPath,
+		ArchFile:      models.DefaultArchFileName,
+		MaxWarnings:   100,
+		BaselineFile:  "",
+		WriteBaseline: false,
+		Watch:         false,
+		WatchInterval: time.Second,
+		FailOn:        models.SeverityError,
+
+		ReportUnusedSuppressions: false,
 	}
 
 	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
 	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
 	cmd.PersistentFlags().IntVar(&in.MaxWarnings, "max-warnings", in.MaxWarnings, "max number of warnings to output")
+	cmd.PersistentFlags().StringVar(&in.BaselineFile, "baseline", in.BaselineFile, "baseline file path (relative to project directory), known warnings from it will be suppressed")
+	cmd.PersistentFlags().BoolVar(&in.WriteBaseline, "write-baseline", in.WriteBaseline, fmt.Sprintf("write all current warnings into baseline file (default file \"%s\")", models.DefaultBaselineFile))
+	cmd.PersistentFlags().BoolVar(&in.Watch, "watch", in.Watch, "watch project files and arch file, and recheck project on every change (output only new and resolved warnings)")
+	cmd.PersistentFlags().DurationVar(&in.WatchInterval, "watch-interval", in.WatchInterval, "how often project files will be polled for changes in watch mode")
+	cmd.PersistentFlags().StringVar(&in.FailOn, "fail-on", in.FailOn, fmt.Sprintf("minimal severity of warnings, that fail check, variants: [%s]", strings.Join(models.Severities(), ", ")))
+	cmd.PersistentFlags().BoolVar(&in.ReportUnusedSuppressions, "report-unused-suppressions", in.ReportUnusedSuppressions, fmt.Sprintf("report '%s' comments, that not suppress any warning", models.SuppressionDirective))
 
 	return cmd, func(act *cobra.Command) (any, error) {
 		const warningsRangeMin = 1
@@ -39,16 +57,57 @@ func (c *Container) commandCheck() (*cobra.Command, runner) {
 			)
 		}
 
+		if !models.IsKnownSeverity(in.FailOn) {
+			return nil, fmt.Errorf(
+				"flag '%s' should be one of [%s]",
+				"fail-on",
+				strings.Join(models.Severities(), ", "),
+			)
+		}
+
+		if in.Watch {
+			return nil, c.commandCheckWatch(act, in)
+		}
+
 		return c.commandCheckOperation().Behave(act.Context(), in)
 	}
 }
 
+func (c *Container) commandCheckWatch(act *cobra.Command, in models.CmdCheckIn) error {
+	if c.flags.OutputType != models.OutputTypeASCII && c.flags.OutputType != models.OutputTypeJSON {
+		return fmt.Errorf("flag --%s not compatible with --%s=%s", "watch", "output-type", c.flags.OutputType)
+	}
+
+	if in.WriteBaseline {
+		return fmt.Errorf("flag --%s not compatible with --%s", "watch", "write-baseline")
+	}
+
+	if in.WatchInterval <= 0 {
+		return fmt.Errorf("flag '%s' should be positive duration", "watch-interval")
+	}
+
+	ctx, stop := signal.NotifyContext(act.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	renderer := c.ProvideRenderer()
+	return c.commandCheckOperation().Watch(ctx, in, func(model any) error {
+		err := renderer.RenderModel(model, nil)
+		if err != nil {
+			return fmt.Errorf("failed to render watch results: %w", err)
+		}
+
+		return nil
+	})
+}
+
 func (c *Container) commandCheckOperation() *check.Operation {
 	return check.NewOperation(
 		c.provideProjectInfoAssembler(),
 		c.provideSpecAssembler(),
 		c.provideSpecChecker(),
 		c.provideReferenceRender(),
+		c.provideBaselineStorage(),
+		c.provideProjectWatcher(),
 		c.flags.UseColors,
 	)
 }
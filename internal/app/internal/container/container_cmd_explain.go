@@ -0,0 +1,39 @@
+package container
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/explain"
+	"github.com/spf13/cobra"
+)
+
+func (c *Container) commandExplain() (*cobra.Command, runner) {
+	cmd := &cobra.Command{
+		Use:   "explain",
+		Short: "explain why file is attached to component",
+		Long:  "display all components matched file package, how one of them was chosen, and rules applied to file imports",
+	}
+
+	in := models.CmdExplainIn{
+		ProjectPath: models.DefaultProjectPath,
+		ArchFile:    models.DefaultArchFileName,
+	}
+
+	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
+	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
+	cmd.PersistentFlags().StringVar(&in.File, "file", in.File, "go file path (absolute or relative to project directory)")
+	cmd.PersistentFlags().StringVar(&in.Package, "package", in.Package, "package directory (absolute or relative to project directory), explain all package files")
+
+	return cmd, func(act *cobra.Command) (any, error) {
+		return c.commandExplainOperation().Behave(act.Context(), in)
+	}
+}
+
+func (c *Container) commandExplainOperation() *explain.Operation {
+	return explain.NewOperation(
+		c.provideSpecAssembler(),
+		c.provideProjectFilesResolver(),
+		c.provideProjectFilesHolder(),
+		c.provideProjectInfoAssembler(),
+		c.provideSpecImportsChecker(),
+	)
+}
@@ -0,0 +1,40 @@
+package container
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/initialize"
+	"github.com/spf13/cobra"
+)
+
+func (c *Container) commandInit() (*cobra.Command, runner) {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "generate starter arch file from project code",
+		Long:  "scan project packages and imports, and generate arch file (v3) with components, vendors and all currently observed deps",
+	}
+
+	in := models.CmdInitIn{
+		ProjectPath: models.DefaultProjectPath,
+		ArchFile:    models.DefaultArchFileName,
+		Workdir:     "",
+		Force:       false,
+		DryRun:      false,
+	}
+
+	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
+	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path to create (relative to project directory)")
+	cmd.PersistentFlags().StringVar(&in.Workdir, "workdir", in.Workdir, "directory with project code (relative to project directory), by default 'internal' if exist, otherwise project root")
+	cmd.PersistentFlags().BoolVar(&in.Force, "force", in.Force, "overwrite existing arch file")
+	cmd.PersistentFlags().BoolVar(&in.DryRun, "dry-run", in.DryRun, "output generated arch file instead of writing it")
+
+	return cmd, func(act *cobra.Command) (any, error) {
+		return c.commandInitOperation().Behave(act.Context(), in)
+	}
+}
+
+func (c *Container) commandInitOperation() *initialize.Operation {
+	return initialize.NewOperation(
+		c.provideProjectInfoAssembler(),
+		c.provideProjectFilesScanner(),
+	)
+}
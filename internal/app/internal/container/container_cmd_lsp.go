@@ -0,0 +1,42 @@
+package container
+
+import (
+	"os"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/lsp"
+	"github.com/spf13/cobra"
+)
+
+func (c *Container) commandLsp() (*cobra.Command, runner) {
+	cmd := &cobra.Command{
+		Use:   "lsp",
+		Short: "run language server (LSP over stdio)",
+		Long:  "run language server, that publish check warnings as editor diagnostics and suggest quick fixes for arch file",
+	}
+
+	in := models.CmdLspIn{
+		ProjectPath: "",
+		ArchFile:    models.DefaultArchFileName,
+	}
+
+	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory (by default workspace root from client)")
+	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
+
+	return cmd, func(act *cobra.Command) (any, error) {
+		// stdout is used by protocol, so all output
+		// is sent to client only as lsp messages
+		return nil, c.commandLspOperation().Behave(act.Context(), in, os.Stdin, os.Stdout)
+	}
+}
+
+func (c *Container) commandLspOperation() *lsp.Operation {
+	return lsp.NewOperation(
+		c.provideProjectInfoAssembler(),
+		c.provideSpecAssembler(),
+		c.provideSpecChecker(),
+		c.provideArchEditor(),
+		c.provideLspServer(),
+		c.version,
+	)
+}
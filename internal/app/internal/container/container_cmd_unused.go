@@ -0,0 +1,35 @@
+package container
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/unused"
+	"github.com/spf13/cobra"
+)
+
+func (c *Container) commandUnused() (*cobra.Command, runner) {
+	cmd := &cobra.Command{
+		Use:   "unused",
+		Short: "find unused deps rules in arch file",
+		Long:  "compare all allowed deps (mayDependOn, canUse) with real project imports and display stale rules",
+	}
+
+	in := models.CmdUnusedIn{
+		ProjectPath: models.DefaultProjectPath,
+		ArchFile:    models.DefaultArchFileName,
+	}
+
+	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
+	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
+
+	return cmd, func(act *cobra.Command) (any, error) {
+		return c.commandUnusedOperation().Behave(act.Context(), in)
+	}
+}
+
+func (c *Container) commandUnusedOperation() *unused.Operation {
+	return unused.NewOperation(
+		c.provideSpecAssembler(),
+		c.provideProjectFilesResolver(),
+		c.provideProjectInfoAssembler(),
+	)
+}
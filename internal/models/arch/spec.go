@@ -2,42 +2,138 @@ package arch
 
 import (
 	"regexp"
+	"time"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/common"
 )
 
+// TestingStdGlobs is always allowed in test files, even
+// when component std imports is limited by canUseStd
+var TestingStdGlobs = []models.Glob{"testing", "testing/**"}
+
 type (
 	Spec struct {
 		RootDirectory       common.Referable[string]
 		WorkingDirectory    common.Referable[string]
 		ModuleName          common.Referable[string]
+		Modules             common.Modules
 		Allow               Allow
 		Components          []Component
+		Layers              []common.Referable[[]string]
+		Vendors             []Vendor
+		Severity            Severities
+		Build               Build
+		DeniedStdGlobs      []common.Referable[models.Glob]
 		Exclude             []common.Referable[models.ResolvedPath]
 		ExcludeFilesMatcher []common.Referable[*regexp.Regexp]
+		IncludedFiles       []string // absolute paths of included arch files (v4+)
 		Integrity           Integrity
 	}
 
 	Allow struct {
 		DepOnAnyVendor common.Referable[bool]
 		DeepScan       common.Referable[bool]
+		DetectCycles   common.Referable[bool]
+		StrictLayers   common.Referable[bool]
+		IgnoreTests    common.Referable[bool]
 	}
 
 	Component struct {
 		Name                  common.Referable[string]
+		DepsReference         common.Reference // 'deps' rule of component, can be in included file
 		DeepScan              common.Referable[bool]
 		ResolvedPaths         []common.Referable[models.ResolvedPath]
+		ExportedPaths         []common.Referable[models.ResolvedPath] // empty = all paths is exported
+		NotExportedImports    []NotExportedImport                     // not exported packages of other components
 		AllowedProjectImports []common.Referable[models.ResolvedPath]
-		AllowedVendorGlobs    []common.Referable[models.Glob]
+		AllowedVendorGlobs    []common.Referable[models.VendorGlob]
+		DeniedProjectImports  []common.Referable[models.ResolvedPath]
+		DeniedVendorGlobs     []common.Referable[models.VendorGlob]
+		AllowedStdGlobs       []common.Referable[models.Glob]
+		DeniedStdGlobs        []common.Referable[models.Glob]
+		AnyCaptureImports     []common.Referable[models.ResolvedPath]
 		MayDependOn           []common.Referable[string]
+		MayNotDependOn        []common.Referable[string]
 		CanUse                []common.Referable[string]
+		CannotUse             []common.Referable[string]
+		AnyCapture            []common.Referable[string]
+		Exceptions            []Exception
+		Severity              Severities
 		SpecialFlags          SpecialFlags
+		Tests                 AdditionalRules
+		Tags                  map[string]AdditionalRules
+		SymbolRules           []SymbolRule
+	}
+
+	// NotExportedImport is package of other component, that not
+	// listed in its exports, so it can't be imported
+	NotExportedImport struct {
+		ComponentName string // owner of package
+		ResolvedPath  common.Referable[models.ResolvedPath]
+	}
+
+	// AdditionalRules is extra allowed imports for some group
+	// of component files (test files, files with build tag)
+	AdditionalRules struct {
+		MayDependOn           []common.Referable[string]
+		CanUse                []common.Referable[string]
+		AllowedProjectImports []common.Referable[models.ResolvedPath]
+		AllowedVendorGlobs    []common.Referable[models.VendorGlob]
+	}
+
+	// Severities is resolved level of warnings for every check kind
+	Severities struct {
+		Imports           common.Referable[models.Severity]
+		Vendors           common.Referable[models.Severity]
+		DeepScan          common.Referable[models.Severity]
+		Cycles            common.Referable[models.Severity]
+		NotMatched        common.Referable[models.Severity]
+		ExpiredExceptions common.Referable[models.Severity]
+		Std               common.Referable[models.Severity]
+		Symbols           common.Referable[models.Severity]
+	}
+
+	// Exception is temporary allowed dependency of component on target
+	// (component or vendor), it's active until end of Expires date
+	Exception struct {
+		Target         common.Referable[string]
+		Reason         common.Referable[string]
+		Owner          common.Referable[string]
+		Ticket         common.Referable[string]
+		Expires        common.Referable[time.Time]
+		ProjectImports []common.Referable[models.ResolvedPath]
+		VendorGlobs    []common.Referable[models.VendorGlob]
+	}
+
+	// SymbolRule is allowed/denied identifiers, that component code
+	// can use from imported target (component or vendor) packages
+	SymbolRule struct {
+		Target         common.Referable[string]
+		Allow          []common.Referable[models.Glob] // empty = all identifiers is allowed
+		Deny           []common.Referable[models.Glob]
+		ProjectImports []common.Referable[models.ResolvedPath]
+		VendorGlobs    []common.Referable[models.VendorGlob]
+	}
+
+	// Build is build constraints evaluation settings, when not
+	// enabled, all project files is scanned
+	Build struct {
+		Enabled bool
+		GOOS    common.Referable[string]
+		GOARCH  common.Referable[string]
+		TagSets [][]string // each set already contain tags, that always set
+	}
+
+	Vendor struct {
+		Name        common.Referable[string]
+		ImportGlobs []common.Referable[models.VendorGlob]
 	}
 
 	SpecialFlags struct {
 		AllowAllProjectDeps common.Referable[bool]
 		AllowAllVendorDeps  common.Referable[bool]
+		SameCapture         common.Referable[bool]
 	}
 
 	Integrity struct {
@@ -50,3 +146,60 @@ type (
 		Ref    common.Reference
 	}
 )
+
+// IsExpired is true, when exception date is already passed
+func (e Exception) IsExpired(now time.Time) bool {
+	return !now.Before(e.Expires.Value.AddDate(0, 0, 1))
+}
+
+// ForFile returns component with rules for given file: production allowed
+// imports + tests rules, own packages (for external "_test" packages) and
+// "testing" std packages when file is test + rules of all file build tags
+func (c Component) ForFile(file models.ProjectFile) Component {
+	additional := make([]AdditionalRules, 0)
+	if file.IsTest() {
+		additional = append(additional, c.Tests)
+	}
+
+	for _, tag := range file.BuildTags {
+		if rules, ok := c.Tags[tag]; ok {
+			additional = append(additional, rules)
+		}
+	}
+
+	if len(additional) == 0 {
+		return c
+	}
+
+	projectImports := make([]common.Referable[models.ResolvedPath], 0, len(c.AllowedProjectImports))
+	projectImports = append(projectImports, c.AllowedProjectImports...)
+
+	vendorGlobs := make([]common.Referable[models.VendorGlob], 0, len(c.AllowedVendorGlobs))
+	vendorGlobs = append(vendorGlobs, c.AllowedVendorGlobs...)
+
+	for _, rules := range additional {
+		projectImports = append(projectImports, rules.AllowedProjectImports...)
+		vendorGlobs = append(vendorGlobs, rules.AllowedVendorGlobs...)
+	}
+
+	if file.IsTest() {
+		projectImports = append(projectImports, c.ResolvedPaths...)
+
+		// empty allow list means, that all not denied std packages is
+		// allowed, testing globs will turn it into allow list with only them
+		if len(c.AllowedStdGlobs) > 0 {
+			stdGlobs := make([]common.Referable[models.Glob], 0, len(c.AllowedStdGlobs)+len(TestingStdGlobs))
+			stdGlobs = append(stdGlobs, c.AllowedStdGlobs...)
+			for _, glob := range TestingStdGlobs {
+				stdGlobs = append(stdGlobs, common.NewEmptyReferable(glob))
+			}
+
+			c.AllowedStdGlobs = stdGlobs
+		}
+	}
+
+	c.AllowedProjectImports = projectImports
+	c.AllowedVendorGlobs = vendorGlobs
+
+	return c
+}
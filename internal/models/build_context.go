@@ -0,0 +1,10 @@
+package models
+
+// BuildContext is build constraints evaluation settings for project
+// files scanning, when not enabled, all files is scanned
+type BuildContext struct {
+	Enabled bool
+	GOOS    string
+	GOARCH  string
+	TagSets [][]string
+}
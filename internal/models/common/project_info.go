@@ -1,10 +1,111 @@
 package common
 
+import (
+	"path/filepath"
+	"strings"
+)
+
 type (
 	Project struct {
 		Directory      string
 		GoArchFilePath string
 		GoModFilePath  string
 		ModuleName     string
+
+		// Modules is all project go modules, it's single root module,
+		// or all modules from go.work 'use' list (workspace mode)
+		Modules Modules
+	}
+
+	Module struct {
+		Name      string    // github.com/example/app
+		Directory string    // absolute path to directory with go.mod
+		Requires  []Require // 'require' list of go.mod
+	}
+
+	Require struct {
+		Path    string // github.com/jackc/pgx/v5
+		Version string // v5.5.0
 	}
+
+	Modules []Module
 )
+
+// ByImportPath find module, that own package with import path
+func (m Modules) ByImportPath(importPath string) (Module, bool) {
+	found, matched := Module{}, false
+
+	for _, module := range m {
+		if importPath != module.Name && !strings.HasPrefix(importPath, module.Name+"/") {
+			continue
+		}
+
+		// nested modules: longest module name wins
+		if !matched || len(module.Name) > len(found.Name) {
+			found, matched = module, true
+		}
+	}
+
+	return found, matched
+}
+
+// ByPath find module, that own file or directory with absolute path
+func (m Modules) ByPath(absPath string) (Module, bool) {
+	found, matched := Module{}, false
+
+	for _, module := range m {
+		if absPath != module.Directory && !strings.HasPrefix(absPath, module.Directory+string(filepath.Separator)) {
+			continue
+		}
+
+		if !matched || len(module.Directory) > len(found.Directory) {
+			found, matched = module, true
+		}
+	}
+
+	return found, matched
+}
+
+// Require find required (in any project go.mod) module, that own vendor
+// package with import path. Nested modules and major versions
+// ("example.com/lib" and "example.com/lib/v2") is different modules
+func (m Modules) Require(importPath string) (Require, bool) {
+	found, matched := Require{}, false
+
+	for _, module := range m {
+		for _, require := range module.Requires {
+			if importPath != require.Path && !strings.HasPrefix(importPath, require.Path+"/") {
+				continue
+			}
+
+			if !matched || len(require.Path) > len(found.Path) {
+				found, matched = require, true
+			}
+		}
+	}
+
+	return found, matched
+}
+
+// Requires returns 'require' lists of all project modules
+func (m Modules) Requires() []Require {
+	list := make([]Require, 0)
+
+	for _, module := range m {
+		list = append(list, module.Requires...)
+	}
+
+	return list
+}
+
+// ImportPath of package in absolute directory, second value
+// is false, when directory is outside of all modules
+func (m Modules) ImportPath(absDirectory string) (string, bool) {
+	module, found := m.ByPath(absDirectory)
+	if !found {
+		return "", false
+	}
+
+	relative := strings.TrimPrefix(absDirectory, module.Directory)
+	return module.Name + filepath.ToSlash(relative), true
+}
@@ -0,0 +1,83 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+func TestModules(t *testing.T) {
+	modules := common.Modules{
+		{Name: "example.com/mono", Directory: "/src/mono"},
+		{Name: "example.com/mono/users", Directory: "/src/mono/services/users"},
+		{Name: "example.com/shared", Directory: "/src/mono/libs/shared"},
+	}
+
+	tests := []struct {
+		name       string
+		directory  string
+		importPath string
+		found      bool
+	}{
+		{name: "root", directory: "/src/mono", importPath: "example.com/mono", found: true},
+		{name: "root package", directory: "/src/mono/cmd/app", importPath: "example.com/mono/cmd/app", found: true},
+		{name: "nested module", directory: "/src/mono/services/users/api", importPath: "example.com/mono/users/api", found: true},
+		{name: "other module", directory: "/src/mono/libs/shared/log", importPath: "example.com/shared/log", found: true},
+		{name: "outside", directory: "/src/monolith/api", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			importPath, found := modules.ImportPath(tt.directory)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.importPath, importPath)
+
+			if !tt.found {
+				return
+			}
+
+			byPath, _ := modules.ByPath(tt.directory)
+			byImport, found := modules.ByImportPath(tt.importPath)
+			assert.True(t, found)
+			assert.Equal(t, byPath, byImport)
+		})
+	}
+
+	_, found := modules.ByImportPath("example.com/monolith/api")
+	assert.False(t, found)
+}
+
+func TestModulesRequire(t *testing.T) {
+	modules := common.Modules{
+		{Name: "example.com/mono", Directory: "/src/mono", Requires: []common.Require{
+			{Path: "github.com/lib/pq", Version: "v1.10.9"},
+			{Path: "github.com/jackc/pgx", Version: "v3.6.2+incompatible"},
+		}},
+		{Name: "example.com/shared", Directory: "/src/mono/libs/shared", Requires: []common.Require{
+			{Path: "github.com/jackc/pgx/v5", Version: "v5.5.0"},
+		}},
+	}
+
+	tests := []struct {
+		name       string
+		importPath string
+		module     string
+		found      bool
+	}{
+		{name: "module root", importPath: "github.com/lib/pq", module: "github.com/lib/pq", found: true},
+		{name: "module package", importPath: "github.com/jackc/pgx/pgtype", module: "github.com/jackc/pgx", found: true},
+		{name: "major version", importPath: "github.com/jackc/pgx/v5/pgxpool", module: "github.com/jackc/pgx/v5", found: true},
+		{name: "same prefix", importPath: "github.com/lib/pqx", found: false},
+		{name: "not required", importPath: "github.com/google/uuid", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require, found := modules.Require(tt.importPath)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.module, require.Path)
+		})
+	}
+}
@@ -1,14 +1,20 @@
 package models
 
 const (
-	OutputTypeDefault OutputType = "default"
-	OutputTypeASCII   OutputType = "ascii"
-	OutputTypeJSON    OutputType = "json"
+	OutputTypeDefault    OutputType = "default"
+	OutputTypeASCII      OutputType = "ascii"
+	OutputTypeJSON       OutputType = "json"
+	OutputTypeSARIF      OutputType = "sarif"
+	OutputTypeJUnit      OutputType = "junit"
+	OutputTypeCheckstyle OutputType = "checkstyle"
 )
 
 var OutputTypeValues = []string{
 	OutputTypeASCII,
 	OutputTypeJSON,
+	OutputTypeSARIF,
+	OutputTypeJUnit,
+	OutputTypeCheckstyle,
 }
 
 type (
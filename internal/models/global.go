@@ -3,12 +3,21 @@ package models
 const UnknownVersion = "dev"
 
 const (
-	DefaultProjectPath   = "./"
-	DefaultArchFileName  = ".go-arch-lint.yml"
-	DefaultGoModFileName = "go.mod"
+	DefaultProjectPath    = "./"
+	DefaultArchFileName   = ".go-arch-lint.yml"
+	DefaultGoModFileName  = "go.mod"
+	DefaultGoWorkFileName = "go.work"
+	DefaultBaselineFile   = ".go-arch-lint-baseline.json"
 )
 
+// SuppressionDirective is comment prefix, that suppress warnings
+// on same line, or on next line (when comment is on own line)
+const SuppressionDirective = "//go-arch-lint:ignore"
+
 const (
 	SupportedVersionMin = 1
-	SupportedVersionMax = 3
+	SupportedVersionMax = 4
 )
+
+// ExceptionDateLayout is format of 'expires' date in arch exceptions
+const ExceptionDateLayout = "2006-01-02"
@@ -0,0 +1,36 @@
+package lsp
+
+import "fmt"
+
+// json-rpc 2.0 error codes
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+)
+
+// ResponseError will be sent to client as json-rpc error object
+type ResponseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func NewMethodNotFoundError(method string) ResponseError {
+	return ResponseError{
+		Code:    CodeMethodNotFound,
+		Message: fmt.Sprintf("method '%s' not supported", method),
+	}
+}
+
+func NewInvalidParamsError(err error) ResponseError {
+	return ResponseError{
+		Code:    CodeInvalidParams,
+		Message: fmt.Sprintf("invalid params: %v", err),
+	}
+}
+
+func (e ResponseError) Error() string {
+	return e.Message
+}
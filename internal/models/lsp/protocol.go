@@ -0,0 +1,140 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Only small subset of Language Server Protocol 3.17, that
+// used by linter. Full spec:
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/
+
+const (
+	SeverityError       DiagnosticSeverity = 1
+	SeverityWarning     DiagnosticSeverity = 2
+	SeverityInformation DiagnosticSeverity = 3
+
+	MessageTypeError   MessageType = 1
+	MessageTypeWarning MessageType = 2
+
+	TextDocumentSyncNone TextDocumentSyncKind = 0
+
+	CodeActionKindQuickFix = "quickfix"
+)
+
+type (
+	DiagnosticSeverity   = int
+	MessageType          = int
+	TextDocumentSyncKind = int
+
+	// Notify send notification from server to client
+	Notify = func(method string, params any) error
+
+	// Handler process one client request (or notification) and return its result
+	Handler = func(ctx context.Context, method string, params json.RawMessage, notify Notify) (any, error)
+
+	Position struct {
+		Line      int `json:"line"`
+		Character int `json:"character"`
+	}
+
+	Range struct {
+		Start Position `json:"start"`
+		End   Position `json:"end"`
+	}
+
+	TextDocumentIdentifier struct {
+		URI string `json:"uri"`
+	}
+
+	DidSaveTextDocumentParams struct {
+		TextDocument TextDocumentIdentifier `json:"textDocument"`
+	}
+
+	DidChangeWatchedFilesParams struct {
+		Changes []FileEvent `json:"changes"`
+	}
+
+	FileEvent struct {
+		URI  string `json:"uri"`
+		Type int    `json:"type"`
+	}
+
+	InitializeParams struct {
+		RootURI  string `json:"rootUri"`
+		RootPath string `json:"rootPath"`
+	}
+
+	InitializeResult struct {
+		Capabilities ServerCapabilities `json:"capabilities"`
+		ServerInfo   ServerInfo         `json:"serverInfo"`
+	}
+
+	ServerCapabilities struct {
+		TextDocumentSync   TextDocumentSyncOptions `json:"textDocumentSync"`
+		CodeActionProvider bool                    `json:"codeActionProvider"`
+	}
+
+	TextDocumentSyncOptions struct {
+		OpenClose bool                 `json:"openClose"`
+		Change    TextDocumentSyncKind `json:"change"`
+		Save      bool                 `json:"save"`
+	}
+
+	ServerInfo struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+
+	PublishDiagnosticsParams struct {
+		URI         string       `json:"uri"`
+		Diagnostics []Diagnostic `json:"diagnostics"`
+	}
+
+	Diagnostic struct {
+		Range    Range              `json:"range"`
+		Severity DiagnosticSeverity `json:"severity"`
+		Code     string             `json:"code,omitempty"`
+		Source   string             `json:"source"`
+		Message  string             `json:"message"`
+		Data     *DiagnosticData    `json:"data,omitempty"`
+	}
+
+	// DiagnosticData is linter specific payload, that client
+	// will send back in code action request
+	DiagnosticData struct {
+		ComponentName string `json:"componentName"`
+		DependOn      string `json:"dependOn"`
+	}
+
+	CodeActionParams struct {
+		TextDocument TextDocumentIdentifier `json:"textDocument"`
+		Range        Range                  `json:"range"`
+		Context      CodeActionContext      `json:"context"`
+	}
+
+	CodeActionContext struct {
+		Diagnostics []Diagnostic `json:"diagnostics"`
+	}
+
+	CodeAction struct {
+		Title       string         `json:"title"`
+		Kind        string         `json:"kind"`
+		Diagnostics []Diagnostic   `json:"diagnostics"`
+		Edit        *WorkspaceEdit `json:"edit,omitempty"`
+	}
+
+	WorkspaceEdit struct {
+		Changes map[string][]TextEdit `json:"changes"`
+	}
+
+	TextEdit struct {
+		Range   Range  `json:"range"`
+		NewText string `json:"newText"`
+	}
+
+	ShowMessageParams struct {
+		Type    MessageType `json:"type"`
+		Message string      `json:"message"`
+	}
+)
@@ -1,12 +1,36 @@
 package models
 
-import "github.com/fe3dback/go-arch-lint/internal/models/common"
+import (
+	"fmt"
+	"time"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+const (
+	BaselineKindDependency BaselineKind = "dependency"
+	BaselineKindMatch      BaselineKind = "not_matched"
+	BaselineKindDeepScan   BaselineKind = "deepscan"
+	BaselineKindCycle      BaselineKind = "cycle"
+	BaselineKindExpired    BaselineKind = "expired_exception"
+	BaselineKindStd        BaselineKind = "std"
+	BaselineKindSymbol     BaselineKind = "symbol"
+)
 
 type (
+	BaselineKind = string
+
 	CmdCheckIn struct {
-		ProjectPath string
-		ArchFile    string
-		MaxWarnings int
+		ProjectPath   string
+		ArchFile      string
+		MaxWarnings   int
+		BaselineFile  string
+		WriteBaseline bool
+		Watch         bool
+		WatchInterval time.Duration
+		FailOn        Severity
+
+		ReportUnusedSuppressions bool
 	}
 
 	CmdCheckOut struct {
@@ -15,9 +39,69 @@ type (
 		ArchWarningsDependency []CheckArchWarningDependency `json:"ArchWarningsDeps"`
 		ArchWarningsMatch      []CheckArchWarningMatch      `json:"ArchWarningsNotMatched"`
 		ArchWarningsDeepScan   []CheckArchWarningDeepscan   `json:"ArchWarningsDeepScan"`
+		ArchWarningsCycles     []CheckArchWarningCycle      `json:"ArchWarningsCycles"`
+		ArchWarningsExpired    []CheckArchWarningExpired    `json:"ArchWarningsExpiredExceptions"`
+		ArchWarningsStd        []CheckArchWarningStd        `json:"ArchWarningsStd"`
+		ArchWarningsSymbols    []CheckArchWarningSymbol     `json:"ArchWarningsSymbols"`
 		OmittedCount           int                          `json:"OmittedCount"`
 		ModuleName             string                       `json:"ModuleName"`
+		ProjectDirectory       string                       `json:"-"`
+		ComponentNames         []string                     `json:"-"`
 		Qualities              []CheckQuality               `json:"Qualities"`
+		Baseline               CheckBaseline                `json:"Baseline"`
+		Suppressed             []CheckSuppressedWarning     `json:"Suppressed"`
+		UnusedSuppressions     []CheckSuppression           `json:"UnusedSuppressions"`
+	}
+
+	// CmdCheckWatchOut is diff between two checks in watch mode
+	CmdCheckWatchOut struct {
+		ChangedFiles        []string                     `json:"ChangedFiles"`
+		ArchReloaded        bool                         `json:"ArchReloaded"`
+		ArchHasWarnings     bool                         `json:"ArchHasWarnings"`
+		WarningsCount       int                          `json:"WarningsCount"`
+		NewWarningsDeps     []CheckArchWarningDependency `json:"NewWarningsDeps"`
+		NewWarningsMatch    []CheckArchWarningMatch      `json:"NewWarningsNotMatched"`
+		NewWarningsDeepScan []CheckArchWarningDeepscan   `json:"NewWarningsDeepScan"`
+		NewWarningsCycles   []CheckArchWarningCycle      `json:"NewWarningsCycles"`
+		NewWarningsExpired  []CheckArchWarningExpired    `json:"NewWarningsExpiredExceptions"`
+		NewWarningsStd      []CheckArchWarningStd        `json:"NewWarningsStd"`
+		NewWarningsSymbols  []CheckArchWarningSymbol     `json:"NewWarningsSymbols"`
+		ResolvedWarnings    []CheckBaselineEntry         `json:"ResolvedWarnings"`
+	}
+
+	CheckBaseline struct {
+		Used            bool                 `json:"Used"`
+		File            string               `json:"File"`
+		Written         bool                 `json:"Written"`
+		SuppressedCount int                  `json:"SuppressedCount"`
+		Fixed           []CheckBaselineEntry `json:"Fixed"`
+	}
+
+	CheckBaselineEntry struct {
+		Fingerprint string       `json:"Fingerprint"`
+		Kind        BaselineKind `json:"Kind"`
+		Component   string       `json:"Component"`
+		File        string       `json:"File"`
+		Target      string       `json:"Target"`
+	}
+
+	// CheckSuppression is "//go-arch-lint:ignore <reason>" comment in project file
+	CheckSuppression struct {
+		ComponentName    string           `json:"ComponentName"`
+		FileRelativePath string           `json:"FileRelativePath"`
+		FileAbsolutePath string           `json:"FileAbsolutePath"`
+		Reason           string           `json:"Reason"`
+		Reference        common.Reference `json:"Reference"`
+	}
+
+	// CheckSuppressedWarning is warning, that ignored by suppression comment
+	CheckSuppressedWarning struct {
+		Kind          BaselineKind     `json:"Kind"`
+		ComponentName string           `json:"ComponentName"`
+		Target        string           `json:"Target"`
+		Reason        string           `json:"Reason"`
+		Reference     common.Reference `json:"Reference"`            // suppressed warning place
+		Suppression   common.Reference `json:"SuppressionReference"` // suppression comment place
 	}
 
 	CheckQuality struct {
@@ -36,20 +120,31 @@ type (
 	}
 
 	CheckArchWarningDependency struct {
+		Severity           Severity         `json:"Severity"`
 		ComponentName      string           `json:"ComponentName"`
 		FileRelativePath   string           `json:"FileRelativePath"`
 		FileAbsolutePath   string           `json:"FileAbsolutePath"`
 		ResolvedImportName string           `json:"ResolvedImportName"`
 		Reference          common.Reference `json:"Reference"`
+
+		// Module is set, when import is from go.mod module, that not
+		// described in any vendor (reported once per module in project)
+		Module string `json:"Module,omitempty"`
+
+		// NotExportedBy is set, when imported package of this
+		// component is not listed in its exports
+		NotExportedBy string `json:"NotExportedBy,omitempty"`
 	}
 
 	CheckArchWarningMatch struct {
+		Severity         Severity         `json:"Severity"`
 		FileRelativePath string           `json:"FileRelativePath"`
 		FileAbsolutePath string           `json:"FileAbsolutePath"`
 		Reference        common.Reference `json:"-"`
 	}
 
 	CheckArchWarningDeepscan struct {
+		Severity   Severity                  `json:"Severity"`
 		Gate       DeepscanWarningGate       `json:"Gate"`
 		Dependency DeepscanWarningDependency `json:"Dependency"`
 		Target     DeepscanWarningTarget     `json:"Target"`
@@ -76,10 +171,73 @@ type (
 		RelativePath string           `json:"-"` // internal/app/internal/container/cmd_mapping.go:15
 	}
 
+	// CheckArchWarningCycle is group of components, that depend on each other
+	CheckArchWarningCycle struct {
+		Severity       Severity                    `json:"Severity"`
+		ComponentNames []string                    `json:"ComponentNames"` // all components in cycle group
+		Chain          []CheckArchWarningCycleStep `json:"Chain"`          // shortest imports path, that close cycle
+	}
+
+	CheckArchWarningCycleStep struct {
+		ComponentName      string           `json:"ComponentName"`
+		DependOn           string           `json:"DependOn"`
+		FileRelativePath   string           `json:"FileRelativePath"`
+		FileAbsolutePath   string           `json:"FileAbsolutePath"`
+		ResolvedImportName string           `json:"ResolvedImportName"`
+		Reference          common.Reference `json:"Reference"`
+	}
+
+	// CheckArchWarningExpired is import, that was allowed by exception
+	// in arch file, but this exception is already expired
+	CheckArchWarningExpired struct {
+		Severity           Severity         `json:"Severity"`
+		ComponentName      string           `json:"ComponentName"`
+		FileRelativePath   string           `json:"FileRelativePath"`
+		FileAbsolutePath   string           `json:"FileAbsolutePath"`
+		ResolvedImportName string           `json:"ResolvedImportName"`
+		Reference          common.Reference `json:"Reference"`
+		Target             string           `json:"Target"`  // component or vendor name
+		Expires            string           `json:"Expires"` // 2024-12-31
+		Reason             string           `json:"Reason"`
+		Owner              string           `json:"Owner"`
+		Ticket             string           `json:"Ticket"`
+		ExceptionReference common.Reference `json:"ExceptionReference"` // exception in arch file
+	}
+
+	// CheckArchWarningStd is not allowed import of std package
+	CheckArchWarningStd struct {
+		Severity           Severity         `json:"Severity"`
+		ComponentName      string           `json:"ComponentName"`
+		FileRelativePath   string           `json:"FileRelativePath"`
+		FileAbsolutePath   string           `json:"FileAbsolutePath"`
+		ResolvedImportName string           `json:"ResolvedImportName"`
+		Reference          common.Reference `json:"Reference"`
+	}
+
+	// CheckArchWarningSymbol is usage of imported package identifier,
+	// that not allowed by symbols rule of component
+	CheckArchWarningSymbol struct {
+		Severity           Severity         `json:"Severity"`
+		ComponentName      string           `json:"ComponentName"`
+		FileRelativePath   string           `json:"FileRelativePath"`
+		FileAbsolutePath   string           `json:"FileAbsolutePath"`
+		ResolvedImportName string           `json:"ResolvedImportName"`
+		Reference          common.Reference `json:"Reference"` // selector expression place
+		Target             string           `json:"Target"`    // component or vendor name
+		Symbol             string           `json:"Symbol"`    // models.UserEntity
+	}
+
 	CheckResult struct {
 		DependencyWarnings []CheckArchWarningDependency
 		MatchWarnings      []CheckArchWarningMatch
 		DeepscanWarnings   []CheckArchWarningDeepscan
+		CycleWarnings      []CheckArchWarningCycle
+		ExpiredWarnings    []CheckArchWarningExpired
+		StdWarnings        []CheckArchWarningStd
+		SymbolWarnings     []CheckArchWarningSymbol
+		SuppressedWarnings []CheckSuppressedWarning
+		Suppressions       []CheckSuppression // all suppression comments in project files
+		Interrupted        bool               // true, when next checkers not run, because of errors in previous
 	}
 )
 
@@ -87,18 +245,73 @@ func (cr *CheckResult) Append(another CheckResult) {
 	cr.DependencyWarnings = append(cr.DependencyWarnings, another.DependencyWarnings...)
 	cr.MatchWarnings = append(cr.MatchWarnings, another.MatchWarnings...)
 	cr.DeepscanWarnings = append(cr.DeepscanWarnings, another.DeepscanWarnings...)
+	cr.CycleWarnings = append(cr.CycleWarnings, another.CycleWarnings...)
+	cr.ExpiredWarnings = append(cr.ExpiredWarnings, another.ExpiredWarnings...)
+	cr.StdWarnings = append(cr.StdWarnings, another.StdWarnings...)
+	cr.SymbolWarnings = append(cr.SymbolWarnings, another.SymbolWarnings...)
+	cr.SuppressedWarnings = append(cr.SuppressedWarnings, another.SuppressedWarnings...)
+	cr.Suppressions = append(cr.Suppressions, another.Suppressions...)
+	cr.Interrupted = cr.Interrupted || another.Interrupted
 }
 
-func (cr *CheckResult) HasNotices() bool {
-	if len(cr.DependencyWarnings) > 0 {
-		return true
+// HasWarningsAtLeast is true, when result contain some warning
+// with same or more important severity than level
+func (cr *CheckResult) HasWarningsAtLeast(level Severity) bool {
+	for _, warn := range cr.DependencyWarnings {
+		if SeverityAtLeast(warn.Severity, level) {
+			return true
+		}
+	}
+	for _, warn := range cr.MatchWarnings {
+		if SeverityAtLeast(warn.Severity, level) {
+			return true
+		}
 	}
-	if len(cr.MatchWarnings) > 0 {
-		return true
+	for _, warn := range cr.DeepscanWarnings {
+		if SeverityAtLeast(warn.Severity, level) {
+			return true
+		}
 	}
-	if len(cr.DeepscanWarnings) > 0 {
-		return true
+	for _, warn := range cr.CycleWarnings {
+		if SeverityAtLeast(warn.Severity, level) {
+			return true
+		}
+	}
+	for _, warn := range cr.ExpiredWarnings {
+		if SeverityAtLeast(warn.Severity, level) {
+			return true
+		}
+	}
+	for _, warn := range cr.StdWarnings {
+		if SeverityAtLeast(warn.Severity, level) {
+			return true
+		}
+	}
+	for _, warn := range cr.SymbolWarnings {
+		if SeverityAtLeast(warn.Severity, level) {
+			return true
+		}
 	}
 
 	return false
 }
+
+// Target is human-readable dependency of warning: import path,
+// or module, that not described in vendors
+func (w CheckArchWarningDependency) Target() string {
+	if w.Module != "" {
+		return fmt.Sprintf("module %s (not described in vendors)", w.Module)
+	}
+
+	return w.ResolvedImportName
+}
+
+// Reason is human-readable cause of warning, when it's not
+// just missing deps rule (empty otherwise)
+func (w CheckArchWarningDependency) Reason() string {
+	if w.NotExportedBy != "" {
+		return fmt.Sprintf("package is not exported by component '%s'", w.NotExportedBy)
+	}
+
+	return ""
+}
@@ -0,0 +1,65 @@
+package models
+
+import "github.com/fe3dback/go-arch-lint/internal/models/common"
+
+const (
+	ExplainImportAllowed    ExplainImportStatus = "allowed"
+	ExplainImportDenied     ExplainImportStatus = "denied"
+	ExplainImportException  ExplainImportStatus = "exception expired"
+	ExplainImportSuppressed ExplainImportStatus = "suppressed"
+)
+
+type (
+	ExplainImportStatus = string
+
+	CmdExplainIn struct {
+		ProjectPath string
+		ArchFile    string
+		File        string // explain single file
+		Package     string // or all files of package directory
+	}
+
+	CmdExplainOut struct {
+		ModuleName string              `json:"ModuleName"`
+		Files      []CmdExplainOutFile `json:"Files"`
+	}
+
+	CmdExplainOutFile struct {
+		FileRelativePath string                   `json:"FileRelativePath"`
+		FileAbsolutePath string                   `json:"FileAbsolutePath"`
+		ComponentName    string                   `json:"ComponentName"` // empty, when file not attached
+		Captures         map[string]string        `json:"Captures"`
+		Candidates       []CmdExplainOutCandidate `json:"Candidates"`
+		TieBreaks        []string                 `json:"TieBreaks"`
+		Rules            []CmdExplainOutRule      `json:"Rules"`
+		Imports          []CmdExplainOutImport    `json:"Imports"`
+	}
+
+	// CmdExplainOutCandidate is component, that match file package
+	CmdExplainOutCandidate struct {
+		ComponentName string              `json:"ComponentName"`
+		FilesCount    int                 `json:"FilesCount"`
+		Paths         []CmdExplainOutGlob `json:"Paths"`
+	}
+
+	CmdExplainOutGlob struct {
+		Glob      string           `json:"Glob"`
+		LocalPath string           `json:"LocalPath"`
+		Reference common.Reference `json:"Reference"`
+	}
+
+	// CmdExplainOutRule is arch file rule of file component (mayDependOn,
+	// canUse, etc..), including additional rules for tests and build tags
+	CmdExplainOutRule struct {
+		Kind      string           `json:"Kind"`
+		Value     string           `json:"Value"`
+		Reference common.Reference `json:"Reference"`
+	}
+
+	CmdExplainOutImport struct {
+		Name      string              `json:"Name"`
+		Type      string              `json:"Type"`
+		Status    ExplainImportStatus `json:"Status"`
+		Reference common.Reference    `json:"Reference"`
+	}
+)
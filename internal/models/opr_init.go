@@ -0,0 +1,21 @@
+package models
+
+type (
+	CmdInitIn struct {
+		ProjectPath string
+		ArchFile    string
+		Workdir     string
+		Force       bool
+		DryRun      bool
+	}
+
+	CmdInitOut struct {
+		ModuleName      string `json:"ModuleName"`
+		ArchFile        string `json:"ArchFile"`
+		Workdir         string `json:"Workdir"`
+		DryRun          bool   `json:"DryRun"`
+		ComponentsCount int    `json:"ComponentsCount"`
+		VendorsCount    int    `json:"VendorsCount"`
+		Document        string `json:"Document"`
+	}
+)
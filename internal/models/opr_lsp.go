@@ -0,0 +1,8 @@
+package models
+
+type (
+	CmdLspIn struct {
+		ProjectPath string
+		ArchFile    string
+	}
+)
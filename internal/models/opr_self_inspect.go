@@ -14,10 +14,23 @@ type (
 		LinterVersion string                        `json:"LinterVersion"`
 		Notices       []CmdSelfInspectOutAnnotation `json:"Notices"`
 		Suggestions   []CmdSelfInspectOutAnnotation `json:"Suggestions"`
+		Exceptions    []CmdSelfInspectOutException  `json:"Exceptions"`
 	}
 
 	CmdSelfInspectOutAnnotation struct {
 		Text      string           `json:"Text"`
 		Reference common.Reference `json:"Reference"`
 	}
+
+	// CmdSelfInspectOutException is temporary allowed dependency from arch file
+	CmdSelfInspectOutException struct {
+		ComponentName string           `json:"ComponentName"`
+		Target        string           `json:"Target"`
+		Reason        string           `json:"Reason"`
+		Owner         string           `json:"Owner"`
+		Ticket        string           `json:"Ticket"`
+		Expires       string           `json:"Expires"` // 2024-12-31
+		Expired       bool             `json:"Expired"`
+		Reference     common.Reference `json:"Reference"`
+	}
 )
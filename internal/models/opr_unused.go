@@ -0,0 +1,31 @@
+package models
+
+import "github.com/fe3dback/go-arch-lint/internal/models/common"
+
+const (
+	UnusedKindComponent UnusedKind = "mayDependOn"
+	UnusedKindVendor    UnusedKind = "canUse"
+)
+
+type (
+	UnusedKind = string
+
+	CmdUnusedIn struct {
+		ProjectPath string
+		ArchFile    string
+	}
+
+	CmdUnusedOut struct {
+		ModuleName string             `json:"ModuleName"`
+		Unused     []CmdUnusedOutRule `json:"Unused"`
+	}
+
+	// CmdUnusedOutRule is allowed dependency in arch file,
+	// that not imported by any component file
+	CmdUnusedOutRule struct {
+		ComponentName  string           `json:"ComponentName"`
+		Kind           UnusedKind       `json:"Kind"`
+		DependencyName string           `json:"DependencyName"`
+		Reference      common.Reference `json:"Reference"`
+	}
+)
@@ -8,22 +8,74 @@ const (
 	ImportTypeVendor
 )
 
+const (
+	FileKindProduction   FileKind = iota
+	FileKindTest                  // *_test.go file of same package
+	FileKindExternalTest          // *_test.go file of "<package>_test" package
+)
+
 type (
 	ImportType uint8
+	FileKind   uint8
 
 	FileHold struct {
 		File        ProjectFile
 		ComponentID *string
+
+		// Captures is values of named wildcards from
+		// component path, that match file package
+		Captures map[string]string
+	}
+
+	// FileHoldExplain is explanation, why file is attached to component
+	FileHoldExplain struct {
+		Hold       FileHold
+		Candidates []HoldCandidate // all components, that match file package
+		TieBreaks  []string        // steps of choosing component from candidates
+	}
+
+	HoldCandidate struct {
+		ComponentName string
+		FilesCount    int                              // count of all project files, matched by component
+		Paths         []common.Referable[ResolvedPath] // component paths, that match file package
 	}
 
 	ProjectFile struct {
-		Path    string
-		Imports []ResolvedImport
+		Path         string
+		Kind         FileKind
+		BuildTags    []string // tags from "//go:build" line (not negated)
+		Imports      []ResolvedImport
+		Suppressions []Suppression
+	}
+
+	// Suppression is "//go-arch-lint:ignore <reason>" comment in code,
+	// all warnings on suppressed line will be ignored
+	Suppression struct {
+		Reason    string
+		Line      int              // suppressed line (comment line, or next line after comment)
+		Reference common.Reference // comment position
 	}
 
 	ResolvedImport struct {
 		Name       string
 		ImportType ImportType
+		Module     string // required go.mod module of vendor import (empty, when not required)
 		Reference  common.Reference
 	}
 )
+
+// IsTest is true for all test files (including external test packages)
+func (f ProjectFile) IsTest() bool {
+	return f.Kind == FileKindTest || f.Kind == FileKindExternalTest
+}
+
+// SuppressionAt returns suppression of file line
+func (f ProjectFile) SuppressionAt(line int) (Suppression, bool) {
+	for _, suppression := range f.Suppressions {
+		if suppression.Line == line {
+			return suppression, true
+		}
+	}
+
+	return Suppression{}, false
+}
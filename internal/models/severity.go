@@ -0,0 +1,37 @@
+package models
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// Severity of check warning, by default all warnings is errors
+type Severity = string
+
+var severityRanks = map[Severity]int{
+	SeverityInfo:    1,
+	SeverityWarning: 2,
+	SeverityError:   3,
+}
+
+// Severities is list of all known levels, from less important to more
+func Severities() []Severity {
+	return []Severity{SeverityInfo, SeverityWarning, SeverityError}
+}
+
+func IsKnownSeverity(severity Severity) bool {
+	_, ok := severityRanks[severity]
+	return ok
+}
+
+// SeverityAtLeast is true, when severity is same or more important than level,
+// unknown (empty) severity is always treated as error
+func SeverityAtLeast(severity Severity, level Severity) bool {
+	rank, ok := severityRanks[severity]
+	if !ok {
+		rank = severityRanks[SeverityError]
+	}
+
+	return rank >= severityRanks[level]
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeverityAtLeast(t *testing.T) {
+	tests := []struct {
+		name     string
+		severity Severity
+		level    Severity
+		want     bool
+	}{
+		{name: "error on error", severity: SeverityError, level: SeverityError, want: true},
+		{name: "warning on error", severity: SeverityWarning, level: SeverityError, want: false},
+		{name: "warning on warning", severity: SeverityWarning, level: SeverityWarning, want: true},
+		{name: "error on info", severity: SeverityError, level: SeverityInfo, want: true},
+		{name: "info on warning", severity: SeverityInfo, level: SeverityWarning, want: false},
+		{name: "empty is error", severity: "", level: SeverityError, want: true},
+		{name: "unknown is error", severity: "fatal", level: SeverityWarning, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SeverityAtLeast(tt.severity, tt.level))
+		})
+	}
+}
+
+func TestCheckResult_HasWarningsAtLeast(t *testing.T) {
+	result := CheckResult{
+		DependencyWarnings: []CheckArchWarningDependency{{Severity: SeverityWarning}},
+		MatchWarnings:      []CheckArchWarningMatch{{Severity: SeverityInfo}},
+	}
+
+	assert.False(t, result.HasWarningsAtLeast(SeverityError))
+	assert.True(t, result.HasWarningsAtLeast(SeverityWarning))
+	assert.True(t, result.HasWarningsAtLeast(SeverityInfo))
+}
@@ -9,13 +9,29 @@ import (
 type (
 	Glob string
 
+	// VendorGlob is glob of vendor packages import paths, or glob
+	// of go.mod modules paths (when Module is true), that match
+	// all packages of required module
+	VendorGlob struct {
+		Glob   Glob
+		Module bool
+	}
+
 	ResolvedPath struct {
 		ImportPath string
 		LocalPath  string
 		AbsPath    string
+		Glob       Glob // source glob (relative to project directory)
+
+		// Captures is values of named wildcards from component glob,
+		// for example "domain/{ctx}/service" -> {"ctx": "billing"}
+		Captures map[string]string
 	}
 )
 
+// named wildcard, that match exactly one directory, like "*"
+var globCaptureRegexp = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+
 // Match check if path is subset of glob, for example:
 //   - github.com/**/library/*/abc
 //
@@ -47,3 +63,90 @@ func (glob Glob) Match(testedPath string) (bool, error) {
 
 	return matcher.MatchString(testedPath), nil
 }
+
+// Match check that vendor import is matched by glob. Module glob match
+// only imports of required modules, so "github.com/jackc/pgx" module
+// not match packages of "github.com/jackc/pgx/v5" module
+func (glob VendorGlob) Match(resolvedImport ResolvedImport) (bool, error) {
+	if !glob.Module {
+		return glob.Glob.Match(resolvedImport.Name)
+	}
+
+	if resolvedImport.Module == "" {
+		return false, nil
+	}
+
+	return glob.Glob.Match(resolvedImport.Module)
+}
+
+func (glob VendorGlob) String() string {
+	if glob.Module {
+		return "module " + string(glob.Glob)
+	}
+
+	return string(glob.Glob)
+}
+
+// WithoutCaptures replace all named wildcards with "*":
+//   - domain/{ctx}/service -> domain/*/service
+func (glob Glob) WithoutCaptures() Glob {
+	return Glob(globCaptureRegexp.ReplaceAllString(string(glob), "*"))
+}
+
+// CaptureNames returns names of all named wildcards in glob
+func (glob Glob) CaptureNames() []string {
+	names := make([]string, 0)
+
+	for _, match := range globCaptureRegexp.FindAllStringSubmatch(string(glob), -1) {
+		names = append(names, match[1])
+	}
+
+	return names
+}
+
+// Captures returns values of named wildcards in tested path, for example
+// glob "domain/{ctx}/*/{layer}" with path "domain/billing/v1/service" will
+// return {"ctx": "billing", "layer": "service"}. Result is nil when glob
+// not contain named wildcards, or path not match it
+func (glob Glob) Captures(testedPath string) map[string]string {
+	names := glob.CaptureNames()
+	if len(names) == 0 {
+		return nil
+	}
+
+	regGlob := globCaptureRegexp.ReplaceAllString(string(glob), "<M_CAPTURE>")
+	regGlob = regexp.QuoteMeta(regGlob)
+	regGlob = strings.ReplaceAll(regGlob, "\\*\\*", ".*")
+	regGlob = strings.ReplaceAll(regGlob, "\\*", "[^/]+")
+	regGlob = strings.ReplaceAll(regGlob, "<M_CAPTURE>", "([^/]+)")
+	regGlob = fmt.Sprintf("^%s$", regGlob)
+
+	matcher, err := regexp.Compile(regGlob)
+	if err != nil {
+		return nil
+	}
+
+	values := matcher.FindStringSubmatch(testedPath)
+	if values == nil {
+		return nil
+	}
+
+	captures := make(map[string]string, len(names))
+	for ind, name := range names {
+		captures[name] = values[ind+1]
+	}
+
+	return captures
+}
+
+// SameCaptures check that all named wildcards, that exist in both
+// paths, has equal values
+func SameCaptures(a, b map[string]string) bool {
+	for name, value := range a {
+		if another, exist := b[name]; exist && another != value {
+			return false
+		}
+	}
+
+	return true
+}
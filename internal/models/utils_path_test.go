@@ -0,0 +1,83 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlob_Captures(t *testing.T) {
+	tests := []struct {
+		name string
+		glob Glob
+		path string
+		want map[string]string
+	}{
+		{
+			name: "without captures",
+			glob: "domain/*/service",
+			path: "domain/billing/service",
+			want: nil,
+		},
+		{
+			name: "one capture",
+			glob: "domain/{ctx}/service",
+			path: "domain/billing/service",
+			want: map[string]string{"ctx": "billing"},
+		},
+		{
+			name: "captures with globs",
+			glob: "internal/domain/{ctx}/*/{layer}/**",
+			path: "internal/domain/billing/v1/service/a/b",
+			want: map[string]string{"ctx": "billing", "layer": "service"},
+		},
+		{
+			name: "not matched",
+			glob: "domain/{ctx}/service",
+			path: "domain/billing/repository",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.glob.Captures(tt.path))
+		})
+	}
+}
+
+func TestGlob_WithoutCaptures(t *testing.T) {
+	assert.Equal(t, Glob("domain/*/service/*/**"), Glob("domain/{ctx}/service/{v}/**").WithoutCaptures())
+}
+
+func TestSameCaptures(t *testing.T) {
+	assert.True(t, SameCaptures(nil, map[string]string{"ctx": "billing"}))
+	assert.True(t, SameCaptures(map[string]string{"ctx": "billing"}, map[string]string{"ctx": "billing", "v": "1"}))
+	assert.True(t, SameCaptures(map[string]string{"v": "2"}, map[string]string{"ctx": "billing"}))
+	assert.False(t, SameCaptures(map[string]string{"ctx": "users"}, map[string]string{"ctx": "billing"}))
+}
+
+func TestVendorGlob_Match(t *testing.T) {
+	pgx := ResolvedImport{Name: "github.com/jackc/pgx/pgtype", Module: "github.com/jackc/pgx"}
+	pgxV5 := ResolvedImport{Name: "github.com/jackc/pgx/v5/pgtype", Module: "github.com/jackc/pgx/v5"}
+	notRequired := ResolvedImport{Name: "github.com/jackc/pgx/v5/pgtype"}
+
+	tests := []struct {
+		name string
+		glob VendorGlob
+		imp  ResolvedImport
+		want bool
+	}{
+		{name: "path glob", glob: VendorGlob{Glob: "github.com/jackc/pgx/**"}, imp: pgxV5, want: true},
+		{name: "module", glob: VendorGlob{Glob: "github.com/jackc/pgx", Module: true}, imp: pgx, want: true},
+		{name: "module major", glob: VendorGlob{Glob: "github.com/jackc/pgx", Module: true}, imp: pgxV5, want: false},
+		{name: "module v5", glob: VendorGlob{Glob: "github.com/jackc/pgx/v5", Module: true}, imp: pgxV5, want: true},
+		{name: "module not required", glob: VendorGlob{Glob: "github.com/jackc/pgx/v5", Module: true}, imp: notRequired, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.glob.Match(tt.imp)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,10 +3,12 @@ package check
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sort"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 )
 
 type (
@@ -15,6 +17,8 @@ type (
 		specAssembler        specAssembler
 		specChecker          specChecker
 		referenceRender      referenceRender
+		baselineStorage      baselineStorage
+		projectWatcher       projectWatcher
 		highlightCodePreview bool
 	}
 
@@ -29,6 +33,8 @@ func NewOperation(
 	specAssembler specAssembler,
 	specChecker specChecker,
 	referenceRender referenceRender,
+	baselineStorage baselineStorage,
+	projectWatcher projectWatcher,
 	highlightCodePreview bool,
 ) *Operation {
 	return &Operation{
@@ -36,6 +42,8 @@ func NewOperation(
 		specAssembler:        specAssembler,
 		specChecker:          specChecker,
 		referenceRender:      referenceRender,
+		baselineStorage:      baselineStorage,
+		projectWatcher:       projectWatcher,
 		highlightCodePreview: highlightCodePreview,
 	}
 }
@@ -51,24 +59,51 @@ func (o *Operation) Behave(ctx context.Context, in models.CmdCheckIn) (models.Cm
 		return models.CmdCheckOut{}, fmt.Errorf("failed to assemble spec: %w", err)
 	}
 
+	model, _, err := o.check(ctx, in, spec)
+	return model, err
+}
+
+// check will return output model and all (not limited) warnings
+func (o *Operation) check(ctx context.Context, in models.CmdCheckIn, spec arch.Spec) (models.CmdCheckOut, models.CheckResult, error) {
+	known, err := o.readBaseline(in, spec)
+	if err != nil {
+		return models.CmdCheckOut{}, models.CheckResult{}, fmt.Errorf("failed to read baseline: %w", err)
+	}
+
 	result := models.CheckResult{}
 	if len(spec.Integrity.DocumentNotices) == 0 {
-		result, err = o.specChecker.Check(ctx, spec)
+		result, err = o.specChecker.CheckFiltered(ctx, spec, o.baselineFilter(in, spec, known))
 		if err != nil {
-			return models.CmdCheckOut{}, fmt.Errorf("failed to check project deps: %w", err)
+			return models.CmdCheckOut{}, result, fmt.Errorf("failed to check project deps: %w", err)
 		}
 	}
 
+	result, baseline, err := o.applyBaseline(in, spec, result, known)
+	if err != nil {
+		return models.CmdCheckOut{}, result, fmt.Errorf("failed to apply baseline: %w", err)
+	}
+
+	unusedSuppressions := o.unusedSuppressions(in, result)
+
 	limitedResult := o.limitResults(result, in.MaxWarnings)
 
 	model := models.CmdCheckOut{
 		ModuleName:             spec.ModuleName.Value,
+		ProjectDirectory:       spec.RootDirectory.Value,
+		ComponentNames:         o.componentNames(spec),
 		DocumentNotices:        o.assembleNotice(spec.Integrity),
-		ArchHasWarnings:        o.resultsHasWarnings(limitedResult.results),
+		ArchHasWarnings:        o.resultsHasWarnings(limitedResult.results) || len(unusedSuppressions) > 0,
 		ArchWarningsDependency: limitedResult.results.DependencyWarnings,
 		ArchWarningsMatch:      limitedResult.results.MatchWarnings,
 		ArchWarningsDeepScan:   limitedResult.results.DeepscanWarnings,
+		ArchWarningsCycles:     limitedResult.results.CycleWarnings,
+		ArchWarningsExpired:    limitedResult.results.ExpiredWarnings,
+		ArchWarningsStd:        limitedResult.results.StdWarnings,
+		ArchWarningsSymbols:    limitedResult.results.SymbolWarnings,
 		OmittedCount:           limitedResult.omittedCount,
+		Baseline:               baseline,
+		Suppressed:             o.suppressedWarnings(result),
+		UnusedSuppressions:     unusedSuppressions,
 		Qualities: []models.CheckQuality{
 			{
 				ID:   "component_imports",
@@ -88,15 +123,167 @@ func (o *Operation) Behave(ctx context.Context, in models.CmdCheckIn) (models.Cm
 				Used: spec.Allow.DeepScan.Value == true,
 				Hint: "switch 'allow.deepScan = true' (or delete) to on",
 			},
+			{
+				ID:   "cycles",
+				Name: "Advanced: import cycles between components",
+				Used: spec.Allow.DetectCycles.Value == true,
+				Hint: "switch 'allow.detectCycles = true' to on (v4+)",
+			},
 		},
 	}
 
-	if model.ArchHasWarnings || len(model.DocumentNotices) > 0 {
-		// normal output with exit code 1
-		return model, models.NewUserSpaceError("check not successful")
+	failed := result.HasWarningsAtLeast(in.FailOn) || len(unusedSuppressions) > 0
+	if failed || len(model.DocumentNotices) > 0 {
+		// normal output with exit code 1, warnings with
+		// less important severity than --fail-on not fail check
+		return model, result, models.NewUserSpaceError("check not successful")
+	}
+
+	return model, result, nil
+}
+
+func (o *Operation) suppressedWarnings(result models.CheckResult) []models.CheckSuppressedWarning {
+	if result.SuppressedWarnings == nil {
+		return []models.CheckSuppressedWarning{}
+	}
+
+	return result.SuppressedWarnings
+}
+
+// unusedSuppressions returns suppression comments, that not suppress any warning.
+// Checkers stop on first failed stage (deepscan will not run when imports is
+// invalid), so it's reported only when all checkers are executed
+func (o *Operation) unusedSuppressions(in models.CmdCheckIn, result models.CheckResult) []models.CheckSuppression {
+	unused := make([]models.CheckSuppression, 0)
+
+	if !in.ReportUnusedSuppressions || result.Interrupted {
+		return unused
+	}
+
+	used := make(map[common.Reference]struct{}, len(result.SuppressedWarnings))
+	for _, warn := range result.SuppressedWarnings {
+		used[warn.Suppression] = struct{}{}
+	}
+
+	for _, suppression := range result.Suppressions {
+		if _, ok := used[suppression.Reference]; ok {
+			continue
+		}
+
+		unused = append(unused, suppression)
+	}
+
+	return unused
+}
+
+// readBaseline returns known (baselined) warnings. Nothing is
+// known, when baseline is not used or will be rewritten
+func (o *Operation) readBaseline(in models.CmdCheckIn, spec arch.Spec) ([]models.CheckBaselineEntry, error) {
+	if in.BaselineFile == "" || in.WriteBaseline {
+		return nil, nil
+	}
+
+	return o.baselineStorage.Read(o.baselinePath(in, spec))
+}
+
+// baselineFilter hides baselined warnings from checkers, so known
+// legacy violations not stop next checkers from running
+func (o *Operation) baselineFilter(
+	in models.CmdCheckIn,
+	spec arch.Spec,
+	known []models.CheckBaselineEntry,
+) func(models.CheckResult) models.CheckResult {
+	if in.WriteBaseline {
+		// all current warnings will be written into baseline,
+		// so baseline should contain results of all checkers
+		return func(models.CheckResult) models.CheckResult {
+			return models.CheckResult{}
+		}
+	}
+
+	if in.BaselineFile == "" {
+		return nil
+	}
+
+	return func(result models.CheckResult) models.CheckResult {
+		filtered, _, _ := o.baselineStorage.Apply(result, known, spec.RootDirectory.Value)
+		return filtered
+	}
+}
+
+// baselinePath resolves relative baseline path from project directory,
+// so baseline can be committed next to arch file
+func (o *Operation) baselinePath(in models.CmdCheckIn, spec arch.Spec) string {
+	baselineFile := in.BaselineFile
+	if baselineFile == "" {
+		baselineFile = models.DefaultBaselineFile
+	}
+
+	if filepath.IsAbs(baselineFile) {
+		return baselineFile
+	}
+
+	return filepath.Join(spec.RootDirectory.Value, baselineFile)
+}
+
+func (o *Operation) applyBaseline(
+	in models.CmdCheckIn,
+	spec arch.Spec,
+	result models.CheckResult,
+	known []models.CheckBaselineEntry,
+) (models.CheckResult, models.CheckBaseline, error) {
+	baseline := models.CheckBaseline{
+		Fixed: []models.CheckBaselineEntry{},
+	}
+
+	if in.BaselineFile == "" && !in.WriteBaseline {
+		return result, baseline, nil
+	}
+
+	baseline.Used = true
+	baseline.File = in.BaselineFile
+	if baseline.File == "" {
+		baseline.File = models.DefaultBaselineFile
+	}
+
+	if in.WriteBaseline {
+		if len(spec.Integrity.DocumentNotices) > 0 {
+			// project is not checked, baseline will be empty and
+			// override all previous suppressions
+			return result, baseline, nil
+		}
+
+		if result.Interrupted {
+			// not all checkers executed, baseline will be incomplete
+			return result, baseline, fmt.Errorf("check interrupted by errors, baseline is not written")
+		}
+
+		known = o.baselineStorage.Entries(result, spec.RootDirectory.Value)
+		err := o.baselineStorage.Write(o.baselinePath(in, spec), known)
+		if err != nil {
+			return result, baseline, fmt.Errorf("failed to write baseline: %w", err)
+		}
+
+		baseline.Written = true
+	}
+
+	result, baseline.SuppressedCount, baseline.Fixed = o.baselineStorage.Apply(
+		result,
+		known,
+		spec.RootDirectory.Value,
+	)
+
+	return result, baseline, nil
+}
+
+func (o *Operation) componentNames(spec arch.Spec) []string {
+	names := make([]string, 0, len(spec.Components))
+	for _, cmp := range spec.Components {
+		names = append(names, cmp.Name.Value)
 	}
 
-	return model, nil
+	sort.Strings(names)
+	return names
 }
 
 func (o *Operation) limitResults(result models.CheckResult, maxWarnings int) limiterResult {
@@ -105,6 +292,10 @@ func (o *Operation) limitResults(result models.CheckResult, maxWarnings int) lim
 		DependencyWarnings: []models.CheckArchWarningDependency{},
 		MatchWarnings:      []models.CheckArchWarningMatch{},
 		DeepscanWarnings:   []models.CheckArchWarningDeepscan{},
+		CycleWarnings:      []models.CheckArchWarningCycle{},
+		ExpiredWarnings:    []models.CheckArchWarningExpired{},
+		StdWarnings:        []models.CheckArchWarningStd{},
+		SymbolWarnings:     []models.CheckArchWarningSymbol{},
 	}
 
 	// append deps
@@ -137,10 +328,54 @@ func (o *Operation) limitResults(result models.CheckResult, maxWarnings int) lim
 		passCount++
 	}
 
+	// append cycles
+	for _, notice := range result.CycleWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.CycleWarnings = append(limitedResults.CycleWarnings, notice)
+		passCount++
+	}
+
+	// append expired exceptions
+	for _, notice := range result.ExpiredWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.ExpiredWarnings = append(limitedResults.ExpiredWarnings, notice)
+		passCount++
+	}
+
+	// append std imports
+	for _, notice := range result.StdWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.StdWarnings = append(limitedResults.StdWarnings, notice)
+		passCount++
+	}
+
+	// append symbols
+	for _, notice := range result.SymbolWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.SymbolWarnings = append(limitedResults.SymbolWarnings, notice)
+		passCount++
+	}
+
 	totalCount := 0 +
 		len(result.DeepscanWarnings) +
 		len(result.DependencyWarnings) +
-		len(result.MatchWarnings)
+		len(result.MatchWarnings) +
+		len(result.CycleWarnings) +
+		len(result.ExpiredWarnings) +
+		len(result.StdWarnings) +
+		len(result.SymbolWarnings)
 
 	return limiterResult{
 		results:      limitedResults,
@@ -161,6 +396,22 @@ func (o *Operation) resultsHasWarnings(result models.CheckResult) bool {
 		return true
 	}
 
+	if len(result.CycleWarnings) > 0 {
+		return true
+	}
+
+	if len(result.ExpiredWarnings) > 0 {
+		return true
+	}
+
+	if len(result.StdWarnings) > 0 {
+		return true
+	}
+
+	if len(result.SymbolWarnings) > 0 {
+		return true
+	}
+
 	return false
 }
 
@@ -2,6 +2,7 @@ package check
 
 import (
 	"context"
+	"time"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
@@ -22,6 +23,31 @@ type (
 	}
 
 	specChecker interface {
-		Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error)
+		CheckFiltered(
+			ctx context.Context,
+			spec arch.Spec,
+			filter func(models.CheckResult) models.CheckResult,
+		) (models.CheckResult, error)
+	}
+
+	baselineStorage interface {
+		Read(filePath string) ([]models.CheckBaselineEntry, error)
+		Write(filePath string, entries []models.CheckBaselineEntry) error
+		Entries(result models.CheckResult, rootDirectory string) []models.CheckBaselineEntry
+		Apply(
+			result models.CheckResult,
+			known []models.CheckBaselineEntry,
+			rootDirectory string,
+		) (models.CheckResult, int, []models.CheckBaselineEntry)
+	}
+
+	projectWatcher interface {
+		Watch(
+			ctx context.Context,
+			directory string,
+			files func() []string,
+			interval time.Duration,
+			onChange func(changed []string) error,
+		) error
 	}
 )
@@ -0,0 +1,123 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+// watchState is kept in memory between checks in watch mode
+type watchState struct {
+	project     common.Project
+	spec        arch.Spec
+	specIsValid bool
+	known       []models.CheckBaselineEntry
+}
+
+// Watch will check project, and then recheck it on every change in project
+// go files, arch file or included arch files, until ctx is done. First result
+// is full check output, next results is only diff (new and resolved warnings)
+// from previous check
+func (o *Operation) Watch(ctx context.Context, in models.CmdCheckIn, render func(model any) error) error {
+	projectInfo, err := o.projectInfoAssembler.ProjectInfo(in.ProjectPath, in.ArchFile)
+	if err != nil {
+		return fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	state := &watchState{
+		project: projectInfo,
+		known:   []models.CheckBaselineEntry{},
+	}
+
+	err = render(o.recheck(ctx, in, state, nil))
+	if err != nil {
+		return err
+	}
+
+	return o.projectWatcher.Watch(
+		ctx,
+		projectInfo.Directory,
+		state.archFiles,
+		in.WatchInterval,
+		func(changed []string) error {
+			return render(o.recheck(ctx, in, state, changed))
+		},
+	)
+}
+
+// archFiles is arch file with all included files
+func (s *watchState) archFiles() []string {
+	return append([]string{s.project.GoArchFilePath}, s.spec.IncludedFiles...)
+}
+
+func (o *Operation) recheck(ctx context.Context, in models.CmdCheckIn, state *watchState, changed []string) any {
+	archFiles := make(map[string]struct{})
+	for _, path := range state.archFiles() {
+		archFiles[path] = struct{}{}
+	}
+
+	archReloaded := !state.specIsValid
+	for _, path := range changed {
+		if _, ok := archFiles[path]; ok {
+			archReloaded = true
+		}
+	}
+
+	if archReloaded {
+		spec, err := o.specAssembler.Assemble(state.project)
+		if err != nil {
+			// try again on next change
+			state.specIsValid = false
+			return models.CmdErrorOut{Error: fmt.Sprintf("failed to assemble spec: %s", err)}
+		}
+
+		state.spec = spec
+		state.specIsValid = true
+	}
+
+	model, result, err := o.check(ctx, in, state.spec)
+	if err != nil && !errors.Is(err, models.UserSpaceError{}) {
+		// code can be temporary broken in the middle of refactoring
+		return models.CmdErrorOut{Error: err.Error()}
+	}
+
+	if len(model.DocumentNotices) > 0 {
+		// project not checked, so keep previous warnings for diff
+		return model
+	}
+
+	rootDirectory := state.spec.RootDirectory.Value
+	newWarnings, _, resolved := o.baselineStorage.Apply(result, state.known, rootDirectory)
+	state.known = o.baselineStorage.Entries(result, rootDirectory)
+
+	if changed == nil {
+		return model
+	}
+
+	changedFiles := make([]string, 0, len(changed))
+	for _, path := range changed {
+		changedFiles = append(changedFiles, strings.TrimPrefix(path, state.project.Directory))
+	}
+
+	limitedResult := o.limitResults(newWarnings, in.MaxWarnings)
+
+	return models.CmdCheckWatchOut{
+		ChangedFiles:        changedFiles,
+		ArchReloaded:        archReloaded,
+		ArchHasWarnings:     len(state.known) > 0,
+		WarningsCount:       len(state.known),
+		NewWarningsDeps:     limitedResult.results.DependencyWarnings,
+		NewWarningsMatch:    limitedResult.results.MatchWarnings,
+		NewWarningsDeepScan: limitedResult.results.DeepscanWarnings,
+		NewWarningsCycles:   limitedResult.results.CycleWarnings,
+		NewWarningsExpired:  limitedResult.results.ExpiredWarnings,
+		NewWarningsStd:      limitedResult.results.StdWarnings,
+		NewWarningsSymbols:  limitedResult.results.SymbolWarnings,
+		ResolvedWarnings:    resolved,
+	}
+}
@@ -0,0 +1,317 @@
+package explain
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type Operation struct {
+	specAssembler        specAssembler
+	projectFilesResolver projectFilesResolver
+	projectFilesHolder   projectFilesHolder
+	projectInfoAssembler projectInfoAssembler
+	importsChecker       importsChecker
+}
+
+func NewOperation(
+	specAssembler specAssembler,
+	projectFilesResolver projectFilesResolver,
+	projectFilesHolder projectFilesHolder,
+	projectInfoAssembler projectInfoAssembler,
+	importsChecker importsChecker,
+) *Operation {
+	return &Operation{
+		specAssembler:        specAssembler,
+		projectFilesResolver: projectFilesResolver,
+		projectFilesHolder:   projectFilesHolder,
+		projectInfoAssembler: projectInfoAssembler,
+		importsChecker:       importsChecker,
+	}
+}
+
+func (o *Operation) Behave(ctx context.Context, in models.CmdExplainIn) (models.CmdExplainOut, error) {
+	if (in.File == "") == (in.Package == "") {
+		return models.CmdExplainOut{}, fmt.Errorf("one of --file or --package should be set")
+	}
+
+	projectInfo, err := o.projectInfoAssembler.ProjectInfo(in.ProjectPath, in.ArchFile)
+	if err != nil {
+		return models.CmdExplainOut{}, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	spec, err := o.specAssembler.Assemble(projectInfo)
+	if err != nil {
+		return models.CmdExplainOut{}, fmt.Errorf("failed to assemble spec: %w", err)
+	}
+
+	if len(spec.Integrity.DocumentNotices) > 0 {
+		return models.CmdExplainOut{}, fmt.Errorf("arch file has %d notices, run 'check' command for details",
+			len(spec.Integrity.DocumentNotices),
+		)
+	}
+
+	projectFiles, err := o.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return models.CmdExplainOut{}, fmt.Errorf("failed to resolve project files: %w", err)
+	}
+
+	files := make([]models.ProjectFile, 0, len(projectFiles))
+	for _, projectFile := range projectFiles {
+		files = append(files, projectFile.File)
+	}
+
+	explainedFiles := selectFiles(files, absPath(spec.RootDirectory.Value, in.File), absPath(spec.RootDirectory.Value, in.Package))
+	if len(explainedFiles) == 0 {
+		target := fmt.Sprintf("file '%s'", in.File)
+		if in.Package != "" {
+			target = fmt.Sprintf("package '%s'", in.Package)
+		}
+
+		return models.CmdExplainOut{}, fmt.Errorf("%s not found in project files (outside of workdir, excluded or not exist)",
+			target,
+		)
+	}
+
+	out := models.CmdExplainOut{
+		ModuleName: spec.ModuleName.Value,
+		Files:      make([]models.CmdExplainOutFile, 0, len(explainedFiles)),
+	}
+
+	for _, file := range explainedFiles {
+		explained, err := o.explainFile(spec, files, file)
+		if err != nil {
+			return models.CmdExplainOut{}, fmt.Errorf("failed to explain file '%s': %w", file.Path, err)
+		}
+
+		out.Files = append(out.Files, explained)
+	}
+
+	return out, nil
+}
+
+func (o *Operation) explainFile(spec arch.Spec, files []models.ProjectFile, file models.ProjectFile) (models.CmdExplainOutFile, error) {
+	explain := o.projectFilesHolder.Explain(files, spec.Components, file.Path)
+
+	out := models.CmdExplainOutFile{
+		FileRelativePath: strings.TrimPrefix(file.Path, spec.RootDirectory.Value),
+		FileAbsolutePath: file.Path,
+		Captures:         explain.Hold.Captures,
+		Candidates:       make([]models.CmdExplainOutCandidate, 0, len(explain.Candidates)),
+		TieBreaks:        explain.TieBreaks,
+		Rules:            []models.CmdExplainOutRule{},
+		Imports:          []models.CmdExplainOutImport{},
+	}
+
+	for _, candidate := range explain.Candidates {
+		paths := make([]models.CmdExplainOutGlob, 0, len(candidate.Paths))
+		for _, path := range candidate.Paths {
+			paths = append(paths, models.CmdExplainOutGlob{
+				Glob:      string(path.Value.Glob),
+				LocalPath: path.Value.LocalPath,
+				Reference: path.Reference,
+			})
+		}
+
+		out.Candidates = append(out.Candidates, models.CmdExplainOutCandidate{
+			ComponentName: candidate.ComponentName,
+			FilesCount:    candidate.FilesCount,
+			Paths:         paths,
+		})
+	}
+
+	if explain.Hold.ComponentID == nil {
+		return out, nil
+	}
+
+	component, found := findComponent(spec, *explain.Hold.ComponentID)
+	if !found {
+		return models.CmdExplainOutFile{}, fmt.Errorf("not found component '%s'", *explain.Hold.ComponentID)
+	}
+
+	result, err := o.importsChecker.CheckFile(spec, component, file, explain.Hold.Captures)
+	if err != nil {
+		return models.CmdExplainOutFile{}, fmt.Errorf("failed to check imports: %w", err)
+	}
+
+	out.ComponentName = component.Name.Value
+	out.Rules = componentRules(spec, component, file)
+	out.Imports = importsStatus(file, result)
+
+	return out, nil
+}
+
+// selectFiles returns explained file, or all files of package directory
+func selectFiles(files []models.ProjectFile, filePath string, packagePath string) []models.ProjectFile {
+	selected := make([]models.ProjectFile, 0)
+
+	for _, file := range files {
+		if filePath != "" && file.Path == filePath {
+			selected = append(selected, file)
+		}
+
+		if packagePath != "" && filepath.Dir(file.Path) == packagePath {
+			selected = append(selected, file)
+		}
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Path < selected[j].Path
+	})
+
+	return selected
+}
+
+func absPath(rootDirectory string, path string) string {
+	if path == "" {
+		return ""
+	}
+
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+
+	return filepath.Join(rootDirectory, path)
+}
+
+func findComponent(spec arch.Spec, name string) (arch.Component, bool) {
+	for _, component := range spec.Components {
+		if component.Name.Value == name {
+			return component, true
+		}
+	}
+
+	return arch.Component{}, false
+}
+
+// componentRules returns all rules of component, that applied to file imports
+func componentRules(spec arch.Spec, component arch.Component, file models.ProjectFile) []models.CmdExplainOutRule {
+	rules := make([]models.CmdExplainOutRule, 0)
+	add := func(kind string, list []common.Referable[string]) {
+		for _, item := range list {
+			rules = append(rules, models.CmdExplainOutRule{
+				Kind:      kind,
+				Value:     item.Value,
+				Reference: item.Reference,
+			})
+		}
+	}
+	addGlobs := func(kind string, list []common.Referable[models.Glob]) {
+		for _, item := range list {
+			add(kind, []common.Referable[string]{common.NewReferable(string(item.Value), item.Reference)})
+		}
+	}
+	addFlag := func(kind string, flag common.Referable[bool]) {
+		if flag.Value {
+			add(kind, []common.Referable[string]{common.NewReferable("true", flag.Reference)})
+		}
+	}
+
+	add("mayDependOn", component.MayDependOn)
+	add("mayNotDependOn", component.MayNotDependOn)
+	add("canUse", component.CanUse)
+	add("cannotUse", component.CannotUse)
+	addFlag("anyProjectDeps", component.SpecialFlags.AllowAllProjectDeps)
+	addFlag("anyVendorDeps", component.SpecialFlags.AllowAllVendorDeps)
+	addFlag("depOnAnyVendor", spec.Allow.DepOnAnyVendor)
+
+	if file.IsTest() {
+		add("tests.mayDependOn", component.Tests.MayDependOn)
+		add("tests.canUse", component.Tests.CanUse)
+
+		if len(component.AllowedStdGlobs) > 0 {
+			for _, glob := range arch.TestingStdGlobs {
+				add("tests.canUseStd", []common.Referable[string]{common.NewEmptyReferable(fmt.Sprintf("%s (implicit)", glob))})
+			}
+		}
+	}
+
+	for _, tag := range file.BuildTags {
+		if tagRules, ok := component.Tags[tag]; ok {
+			add(fmt.Sprintf("tags.%s.mayDependOn", tag), tagRules.MayDependOn)
+			add(fmt.Sprintf("tags.%s.canUse", tag), tagRules.CanUse)
+		}
+	}
+
+	addGlobs("canUseStd", component.AllowedStdGlobs)
+	addGlobs("cannotUseStd", component.DeniedStdGlobs)
+	addGlobs("cannotUseStd (global)", spec.DeniedStdGlobs)
+
+	for _, exception := range component.Exceptions {
+		add("exception", []common.Referable[string]{common.NewReferable(
+			fmt.Sprintf("%s (expires %s)", exception.Target.Value, exception.Expires.Value.Format(models.ExceptionDateLayout)),
+			exception.Target.Reference,
+		)})
+	}
+
+	for _, symbolRule := range component.SymbolRules {
+		add("symbols", []common.Referable[string]{symbolRule.Target})
+	}
+
+	return rules
+}
+
+// importsStatus returns file imports with check result of every import
+func importsStatus(file models.ProjectFile, result models.CheckResult) []models.CmdExplainOutImport {
+	statuses := make(map[string]models.ExplainImportStatus)
+	deniedModules := make(map[string]struct{})
+
+	for _, warn := range result.DependencyWarnings {
+		statuses[warn.ResolvedImportName] = models.ExplainImportDenied
+
+		if warn.Module != "" {
+			deniedModules[warn.Module] = struct{}{}
+		}
+	}
+
+	for _, warn := range result.StdWarnings {
+		statuses[warn.ResolvedImportName] = models.ExplainImportDenied
+	}
+
+	for _, warn := range result.ExpiredWarnings {
+		statuses[warn.ResolvedImportName] = models.ExplainImportException
+	}
+
+	for _, warn := range result.SuppressedWarnings {
+		statuses[warn.Target] = models.ExplainImportSuppressed
+	}
+
+	imports := make([]models.CmdExplainOutImport, 0, len(file.Imports))
+	for _, resolvedImport := range file.Imports {
+		status, found := statuses[resolvedImport.Name]
+		if !found {
+			status = models.ExplainImportAllowed
+		}
+
+		if _, denied := deniedModules[resolvedImport.Module]; denied && resolvedImport.Module != "" && !found {
+			// undeclared module reported only once, by first import
+			status = models.ExplainImportDenied
+		}
+
+		imports = append(imports, models.CmdExplainOutImport{
+			Name:      resolvedImport.Name,
+			Type:      importTypeName(resolvedImport.ImportType),
+			Status:    status,
+			Reference: resolvedImport.Reference,
+		})
+	}
+
+	return imports
+}
+
+func importTypeName(importType models.ImportType) string {
+	switch importType {
+	case models.ImportTypeStdLib:
+		return "std"
+	case models.ImportTypeVendor:
+		return "vendor"
+	default:
+		return "project"
+	}
+}
@@ -0,0 +1,36 @@
+package explain
+
+import (
+	"context"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type (
+	specAssembler interface {
+		Assemble(prj common.Project) (arch.Spec, error)
+	}
+
+	projectFilesResolver interface {
+		ProjectFiles(ctx context.Context, spec arch.Spec) ([]models.FileHold, error)
+	}
+
+	projectFilesHolder interface {
+		Explain(files []models.ProjectFile, components []arch.Component, filePath string) models.FileHoldExplain
+	}
+
+	projectInfoAssembler interface {
+		ProjectInfo(rootDirectory string, archFilePath string) (common.Project, error)
+	}
+
+	importsChecker interface {
+		CheckFile(
+			spec arch.Spec,
+			component arch.Component,
+			file models.ProjectFile,
+			captures map[string]string,
+		) (models.CheckResult, error)
+	}
+)
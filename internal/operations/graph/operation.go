@@ -20,10 +20,15 @@ import (
 	"oss.terrastruct.com/d2/d2renderers/d2svg"
 )
 
-type Operation struct {
-	specAssembler        specAssembler
-	projectInfoAssembler projectInfoAssembler
-}
+type (
+	Operation struct {
+		specAssembler        specAssembler
+		projectInfoAssembler projectInfoAssembler
+	}
+
+	// nodeIDs is map of component name to d2 shape id
+	nodeIDs map[string]string
+)
 
 func NewOperation(
 	specAssembler specAssembler,
@@ -77,6 +82,14 @@ func (o *Operation) Behave(ctx context.Context, in models.CmdGraphIn) (models.Cm
 	}, nil
 }
 
+func (ids nodeIDs) id(cmpName string) string {
+	if id, ok := ids[cmpName]; ok {
+		return id
+	}
+
+	return cmpName
+}
+
 func (o *Operation) isFileShouldBeWritten(in models.CmdGraphIn) bool {
 	if in.OutputType == models.OutputTypeJSON {
 		return false
@@ -96,6 +109,7 @@ func (o *Operation) buildGraph(spec arch.Spec, opts models.CmdGraphIn) ([]byte,
 	}
 
 	flow := o.componentsFlowArrow(opts)
+	nodes := o.layersNodes(spec, whiteList)
 
 	linesBuff := make([]string, 0, 256)
 
@@ -109,14 +123,14 @@ func (o *Operation) buildGraph(spec arch.Spec, opts models.CmdGraphIn) ([]byte,
 				continue
 			}
 
-			linesBuff = append(linesBuff, fmt.Sprintf("%s %s %s\n", cmp.Name.Value, flow, dep.Value))
+			linesBuff = append(linesBuff, fmt.Sprintf("%s %s %s\n", nodes.id(cmp.Name.Value), flow, nodes.id(dep.Value)))
 		}
 
 		if opts.IncludeVendors {
 			for _, vnd := range cmp.CanUse {
 				vars := map[string]string{
 					"vnd": vnd.Value,
-					"cmp": cmp.Name.Value,
+					"cmp": nodes.id(cmp.Name.Value),
 				}
 
 				tpl := `
@@ -142,6 +156,10 @@ func (o *Operation) buildGraph(spec arch.Spec, opts models.CmdGraphIn) ([]byte,
 	var buff bytes.Buffer
 	sort.Strings(linesBuff)
 
+	for _, band := range o.layersBands(spec, whiteList) {
+		buff.WriteString(band)
+	}
+
 	for _, line := range linesBuff {
 		buff.WriteString(strings.ReplaceAll(line, "\t", ""))
 	}
@@ -149,6 +167,60 @@ func (o *Operation) buildGraph(spec arch.Spec, opts models.CmdGraphIn) ([]byte,
 	return buff.Bytes(), nil
 }
 
+// layersNodes returns d2 id for every component in layers,
+// this components will be rendered inside of layer container
+func (o *Operation) layersNodes(spec arch.Spec, whiteList map[string]struct{}) nodeIDs {
+	nodes := make(nodeIDs)
+
+	for ind, layer := range spec.Layers {
+		for _, cmpName := range layer.Value {
+			if _, visible := whiteList[cmpName]; !visible {
+				continue
+			}
+
+			nodes[cmpName] = fmt.Sprintf("%s.%s", layerID(ind), cmpName)
+		}
+	}
+
+	return nodes
+}
+
+// layersBands render every layer as horizontal container with components,
+// layers is placed from top to bottom by dependencies between them
+func (o *Operation) layersBands(spec arch.Spec, whiteList map[string]struct{}) []string {
+	lines := make([]string, 0, len(spec.Layers))
+
+	for ind, layer := range spec.Layers {
+		visible := make([]string, 0, len(layer.Value))
+		for _, cmpName := range layer.Value {
+			if _, ok := whiteList[cmpName]; ok {
+				visible = append(visible, cmpName)
+			}
+		}
+
+		if len(visible) == 0 {
+			continue
+		}
+
+		var band strings.Builder
+		band.WriteString(fmt.Sprintf("%s: \"layer %d\" {\n", layerID(ind), ind+1))
+		band.WriteString("  style.fill: \"#F7F8FE\"\n")
+		band.WriteString("  style.stroke-dash: 3\n")
+		for _, cmpName := range visible {
+			band.WriteString(fmt.Sprintf("  %s\n", cmpName))
+		}
+		band.WriteString("}\n")
+
+		lines = append(lines, band.String())
+	}
+
+	return lines
+}
+
+func layerID(ind int) string {
+	return fmt.Sprintf("layer-%d", ind+1)
+}
+
 func (o *Operation) componentsFlowArrow(opts models.CmdGraphIn) string {
 	if opts.Type == models.GraphTypeFlow {
 		return "->"
@@ -0,0 +1,338 @@
+package initialize
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+type (
+	// layout is observed project structure, that will be
+	// rendered into starter arch file
+	layout struct {
+		moduleName    string
+		workdir       string
+		requires      []string
+		nestedModules []string // relative to workdir
+
+		components map[string]*layoutComponent
+		vendors    map[string]string // name -> module
+		exclude    map[string]struct{}
+	}
+
+	layoutComponent struct {
+		in             string
+		mayDependOn    map[string]struct{}
+		canUse         map[string]struct{}
+		anyProjectDeps bool // import project packages outside of workdir
+	}
+
+	layoutImport struct {
+		component string
+		models.ResolvedImport
+	}
+)
+
+func newLayout(moduleName string, workdir string, requires []string, nestedModules []string) *layout {
+	return &layout{
+		moduleName:    moduleName,
+		workdir:       workdir,
+		requires:      requires,
+		nestedModules: nestedModules,
+		components:    map[string]*layoutComponent{},
+		vendors:       map[string]string{},
+		exclude:       map[string]struct{}{},
+	}
+}
+
+func (l *layout) observe(workdirPath string, files []models.ProjectFile) *layout {
+	imports := make([]layoutImport, 0)
+
+	for _, file := range files {
+		relPath, err := filepath.Rel(workdirPath, file.Path)
+		if err != nil {
+			continue
+		}
+
+		relDir := path.Dir(filepath.ToSlash(relPath))
+		if ignored, ok := ignoredDirectory(relDir); ok {
+			// go tool also ignore this directories
+			l.exclude[ignored] = struct{}{}
+			continue
+		}
+
+		if module, ok := l.nestedModule(relDir); ok {
+			// separate module (test fixtures, tools, etc..), not part of project
+			l.exclude[module] = struct{}{}
+			continue
+		}
+
+		name := l.component(relDir)
+		for _, fileImport := range file.Imports {
+			imports = append(imports, layoutImport{component: name, ResolvedImport: fileImport})
+		}
+	}
+
+	vendorModules := make(map[string]struct{})
+	for _, fileImport := range imports {
+		if fileImport.ImportType == models.ImportTypeVendor {
+			vendorModules[vendorModule(fileImport.Name, l.requires)] = struct{}{}
+		}
+	}
+
+	vendorNames := l.nameVendors(sortedKeys(vendorModules))
+
+	for _, fileImport := range imports {
+		cmp := l.components[fileImport.component]
+
+		switch fileImport.ImportType {
+		case models.ImportTypeProject:
+			// sub packages of one component also should be
+			// allowed to import each other, so self deps is kept
+			target, ok := l.importComponent(fileImport.Name)
+			if !ok {
+				// package outside of workdir, not covered by any component
+				cmp.anyProjectDeps = true
+				continue
+			}
+
+			cmp.mayDependOn[target] = struct{}{}
+		case models.ImportTypeVendor:
+			cmp.canUse[vendorNames[vendorModule(fileImport.Name, l.requires)]] = struct{}{}
+		}
+	}
+
+	return l
+}
+
+// component will return (and create if not exist) component
+// for top-level package tree in workdir
+func (l *layout) component(relDir string) string {
+	name := rootComponentName
+	in := "."
+
+	if relDir != "." {
+		name = strings.Split(relDir, "/")[0]
+		in = name + "/**"
+	}
+
+	if _, exist := l.components[name]; !exist {
+		l.components[name] = &layoutComponent{
+			in:          in,
+			mayDependOn: map[string]struct{}{},
+			canUse:      map[string]struct{}{},
+		}
+	}
+
+	return name
+}
+
+func (l *layout) nestedModule(relDir string) (string, bool) {
+	for _, module := range l.nestedModules {
+		if relDir == module || strings.HasPrefix(relDir, module+"/") {
+			return module, true
+		}
+	}
+
+	return "", false
+}
+
+func (l *layout) importComponent(importPath string) (string, bool) {
+	prefix := l.moduleName
+	if l.workdir != workdirRoot {
+		prefix = l.moduleName + "/" + l.workdir
+	}
+
+	if importPath == prefix {
+		_, exist := l.components[rootComponentName]
+		return rootComponentName, exist
+	}
+
+	if !strings.HasPrefix(importPath, prefix+"/") {
+		// outside of workdir
+		return "", false
+	}
+
+	name := strings.Split(strings.TrimPrefix(importPath, prefix+"/"), "/")[0]
+	_, exist := l.components[name]
+	return name, exist
+}
+
+// nameVendors give unique short name for every module, more
+// path segments used for modules with same short name
+func (l *layout) nameVendors(modules []string) map[string]string {
+	depth := make(map[string]int, len(modules))
+	for _, module := range modules {
+		depth[module] = 1
+	}
+
+	for {
+		byName := make(map[string][]string)
+		for _, module := range modules {
+			name := vendorName(module, depth[module])
+			byName[name] = append(byName[name], module)
+		}
+
+		changed := false
+		for _, group := range byName {
+			if len(group) < 2 {
+				continue
+			}
+
+			for _, module := range group {
+				if vendorName(module, depth[module]+1) != vendorName(module, depth[module]) {
+					depth[module]++
+					changed = true
+				}
+			}
+		}
+
+		if !changed {
+			break
+		}
+	}
+
+	names := make(map[string]string, len(modules))
+	for _, module := range modules {
+		name := vendorName(module, depth[module])
+		names[module] = name
+		l.vendors[name] = module
+	}
+
+	return names
+}
+
+func (l *layout) render() string {
+	var b strings.Builder
+
+	b.WriteString("# Generated by `go-arch-lint init` from current project imports.\n")
+	b.WriteString("# Review all rules before use, full syntax description:\n")
+	b.WriteString("# https://github.com/fe3dback/go-arch-lint/blob/master/docs/syntax/README.md\n")
+	b.WriteString("version: 3\n")
+	b.WriteString(fmt.Sprintf("workdir: %s\n", l.workdir))
+	b.WriteString("allow:\n")
+	b.WriteString("  depOnAnyVendor: false\n")
+	b.WriteString("  deepScan: false # init observe only imports, switch on after deps review\n")
+	b.WriteString("\n")
+	b.WriteString("excludeFiles:\n")
+	b.WriteString(fmt.Sprintf("  - %q\n", excludeTestFiles))
+
+	if len(l.exclude) > 0 {
+		b.WriteString("\n")
+		b.WriteString("exclude:\n")
+		for _, dir := range sortedKeys(l.exclude) {
+			b.WriteString(fmt.Sprintf("  - %s\n", dir))
+		}
+	}
+
+	if len(l.vendors) > 0 {
+		vendorNames := sortedKeys(l.vendors)
+		width := maxLen(vendorNames)
+
+		b.WriteString("\n")
+		b.WriteString("# external modules, imported by project code\n")
+		b.WriteString("vendors:\n")
+		for _, name := range vendorNames {
+			module := l.vendors[name]
+			b.WriteString(fmt.Sprintf("  %s { in: [ %s, %s/** ] }\n", pad(name+":", width+1), module, module))
+		}
+	}
+
+	componentNames := sortedKeys(l.components)
+	width := maxLen(componentNames)
+
+	b.WriteString("\n")
+	b.WriteString("# one component for every top-level package tree in workdir\n")
+	b.WriteString("components:\n")
+	for _, name := range componentNames {
+		b.WriteString(fmt.Sprintf("  %s { in: %s }\n", pad(name+":", width+1), l.components[name].in))
+	}
+
+	b.WriteString("\n")
+	b.WriteString("# all currently observed dependencies between components\n")
+
+	deps := make([]string, 0)
+	for _, name := range componentNames {
+		cmp := l.components[name]
+		if len(cmp.mayDependOn) == 0 && len(cmp.canUse) == 0 && !cmp.anyProjectDeps {
+			continue
+		}
+
+		deps = append(deps, fmt.Sprintf("  %s:\n", name))
+		if cmp.anyProjectDeps {
+			deps = append(deps, "    anyProjectDeps: true # imports packages outside of workdir\n")
+		}
+
+		if len(cmp.mayDependOn) > 0 {
+			deps = append(deps, "    mayDependOn:\n")
+			for _, dep := range sortedKeys(cmp.mayDependOn) {
+				deps = append(deps, fmt.Sprintf("      - %s\n", dep))
+			}
+		}
+
+		if len(cmp.canUse) > 0 {
+			deps = append(deps, "    canUse:\n")
+			for _, vendor := range sortedKeys(cmp.canUse) {
+				deps = append(deps, fmt.Sprintf("      - %s\n", vendor))
+			}
+		}
+	}
+
+	if len(deps) == 0 {
+		b.WriteString("deps: {}\n")
+		return b.String()
+	}
+
+	b.WriteString("deps:\n")
+	for _, line := range deps {
+		b.WriteString(line)
+	}
+
+	return b.String()
+}
+
+// ignoredDirectory check that path contain directory ignored by go tool
+// (vendor, testdata, hidden), and return path to this directory
+func ignoredDirectory(relDir string) (string, bool) {
+	if relDir == "." {
+		return "", false
+	}
+
+	segments := strings.Split(relDir, "/")
+	for ind, segment := range segments {
+		if segment == "vendor" || segment == "testdata" || strings.HasPrefix(segment, ".") || strings.HasPrefix(segment, "_") {
+			return strings.Join(segments[:ind+1], "/"), true
+		}
+	}
+
+	return "", false
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	list := make([]string, 0, len(m))
+	for key := range m {
+		list = append(list, key)
+	}
+
+	sort.Strings(list)
+	return list
+}
+
+func maxLen(list []string) int {
+	width := 0
+	for _, item := range list {
+		if len(item) > width {
+			width = len(item)
+		}
+	}
+
+	return width
+}
+
+func pad(s string, width int) string {
+	return s + strings.Repeat(" ", width-len(s))
+}
@@ -0,0 +1,250 @@
+package initialize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+const (
+	workdirAuto     = ""
+	workdirInternal = "internal"
+	workdirRoot     = "."
+
+	// files in workdir root, not in any sub package
+	rootComponentName = "root"
+
+	excludeTestFiles = "^.*_test\\.go$"
+)
+
+type Operation struct {
+	projectInfoAssembler projectInfoAssembler
+	projectFilesScanner  projectFilesScanner
+}
+
+func NewOperation(
+	projectInfoAssembler projectInfoAssembler,
+	projectFilesScanner projectFilesScanner,
+) *Operation {
+	return &Operation{
+		projectInfoAssembler: projectInfoAssembler,
+		projectFilesScanner:  projectFilesScanner,
+	}
+}
+
+func (o *Operation) Behave(ctx context.Context, in models.CmdInitIn) (models.CmdInitOut, error) {
+	projectInfo, err := o.projectInfoAssembler.ModuleInfo(in.ProjectPath)
+	if err != nil {
+		return models.CmdInitOut{}, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	if len(projectInfo.Modules) != 1 || projectInfo.Modules[0].Directory != projectInfo.Directory {
+		return models.CmdInitOut{}, fmt.Errorf("init is not supported for '%s' workspaces, run it in module directory", models.DefaultGoWorkFileName)
+	}
+
+	archFilePath := in.ArchFile
+	if !filepath.IsAbs(archFilePath) {
+		archFilePath = filepath.Join(projectInfo.Directory, archFilePath)
+	}
+
+	if !in.DryRun && !in.Force {
+		if _, err := os.Stat(archFilePath); err == nil {
+			return models.CmdInitOut{}, fmt.Errorf("arch file '%s' already exist, use '--force' flag to overwrite it", in.ArchFile)
+		}
+	}
+
+	workdir, err := resolveWorkdir(projectInfo.Directory, in.Workdir)
+	if err != nil {
+		return models.CmdInitOut{}, fmt.Errorf("failed to resolve workdir: %w", err)
+	}
+
+	requires, err := o.projectInfoAssembler.ModuleRequires(projectInfo.GoModFilePath)
+	if err != nil {
+		return models.CmdInitOut{}, fmt.Errorf("failed to read module requires: %w", err)
+	}
+
+	files, err := o.projectFilesScanner.Scan(
+		ctx,
+		filepath.Join(projectInfo.Directory, workdir),
+		projectInfo.Modules,
+		[]models.ResolvedPath{},
+		[]*regexp.Regexp{regexp.MustCompile(excludeTestFiles)},
+		models.BuildContext{},
+	)
+	if err != nil {
+		return models.CmdInitOut{}, fmt.Errorf("failed to scan project files: %w", err)
+	}
+
+	workdirPath := filepath.Join(projectInfo.Directory, workdir)
+
+	nestedModules, err := findNestedModules(workdirPath)
+	if err != nil {
+		return models.CmdInitOut{}, fmt.Errorf("failed to find nested modules: %w", err)
+	}
+
+	doc := newLayout(projectInfo.ModuleName, workdir, requires, nestedModules).
+		observe(workdirPath, files)
+
+	if len(doc.components) == 0 {
+		return models.CmdInitOut{}, fmt.Errorf("not found any go files in workdir '%s'", workdir)
+	}
+
+	document := doc.render()
+
+	if !in.DryRun {
+		err = os.WriteFile(archFilePath, []byte(document), 0o644)
+		if err != nil {
+			return models.CmdInitOut{}, fmt.Errorf("failed write arch file into '%s': %w", archFilePath, err)
+		}
+	}
+
+	return models.CmdInitOut{
+		ModuleName:      projectInfo.ModuleName,
+		ArchFile:        in.ArchFile,
+		Workdir:         workdir,
+		DryRun:          in.DryRun,
+		ComponentsCount: len(doc.components),
+		VendorsCount:    len(doc.vendors),
+		Document:        document,
+	}, nil
+}
+
+func resolveWorkdir(projectDirectory string, workdir string) (string, error) {
+	if workdir == workdirAuto {
+		// most of go projects keep all code in internal
+		info, err := os.Stat(filepath.Join(projectDirectory, workdirInternal))
+		if err == nil && info.IsDir() {
+			return workdirInternal, nil
+		}
+
+		return workdirRoot, nil
+	}
+
+	workdir = filepath.ToSlash(filepath.Clean(workdir))
+	if filepath.IsAbs(workdir) || workdir == ".." || strings.HasPrefix(workdir, "../") {
+		return "", fmt.Errorf("workdir '%s' should be relative path inside project", workdir)
+	}
+
+	info, err := os.Stat(filepath.Join(projectDirectory, workdir))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("workdir '%s' not exist", workdir)
+		}
+
+		return "", fmt.Errorf("failed stat workdir '%s': %w", workdir, err)
+	}
+
+	if !info.IsDir() {
+		return "", fmt.Errorf("workdir '%s' is not directory", workdir)
+	}
+
+	return workdir, nil
+}
+
+// findNestedModules returns directories (relative to workdir) with own go.mod,
+// go tool not treat this directories as part of project module
+func findNestedModules(workdirPath string) ([]string, error) {
+	modules := make([]string, 0)
+
+	err := filepath.WalkDir(workdirPath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.IsDir() || path == workdirPath {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(workdirPath, path)
+		if err != nil {
+			return err
+		}
+
+		relDir := filepath.ToSlash(relPath)
+		if _, ignored := ignoredDirectory(relDir); ignored {
+			return filepath.SkipDir
+		}
+
+		if _, err := os.Stat(filepath.Join(path, models.DefaultGoModFileName)); err != nil {
+			return nil
+		}
+
+		modules = append(modules, relDir)
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return modules, nil
+}
+
+// vendorModule find go.mod require that provide this import,
+// when not found (replaced, not tidy, etc..) import path itself is used
+func vendorModule(importPath string, requires []string) string {
+	found := ""
+
+	for _, module := range requires {
+		if importPath != module && !strings.HasPrefix(importPath, module+"/") {
+			continue
+		}
+
+		if len(module) > len(found) {
+			found = module
+		}
+	}
+
+	if found == "" {
+		return importPath
+	}
+
+	return found
+}
+
+// vendorName is short human name for module, like
+// "github.com/spf13/cobra" -> "cobra"
+// "github.com/logrusorgru/aurora/v3" -> "aurora"
+// "gopkg.in/yaml.v3" -> "yaml"
+// when depth > 1, more path segments is used ("spf13-cobra")
+func vendorName(module string, depth int) string {
+	segments := make([]string, 0)
+
+	for _, segment := range strings.Split(module, "/") {
+		if isMajorVersion(segment) {
+			continue
+		}
+
+		if ind := strings.LastIndex(segment, ".v"); ind > 0 && isMajorVersion(segment[ind+1:]) {
+			segment = segment[:ind]
+		}
+
+		segments = append(segments, segment)
+	}
+
+	if depth > len(segments) {
+		depth = len(segments)
+	}
+
+	return strings.Join(segments[len(segments)-depth:], "-")
+}
+
+func isMajorVersion(segment string) bool {
+	if len(segment) < 2 || segment[0] != 'v' {
+		return false
+	}
+
+	for _, r := range segment[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,27 @@
+package initialize
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type (
+	projectInfoAssembler interface {
+		ModuleInfo(rootDirectory string) (common.Project, error)
+		ModuleRequires(goModFilePath string) ([]string, error)
+	}
+
+	projectFilesScanner interface {
+		Scan(
+			ctx context.Context,
+			projectDirectory string,
+			modules common.Modules,
+			excludePaths []models.ResolvedPath,
+			excludeFileMatchers []*regexp.Regexp,
+			buildContext models.BuildContext,
+		) ([]models.ProjectFile, error)
+	}
+)
@@ -0,0 +1,265 @@
+package lsp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	protocol "github.com/fe3dback/go-arch-lint/internal/models/lsp"
+)
+
+// same codes as in machine-readable check reports
+const (
+	codeDependency = "arch-deps"
+	codeMatch      = "arch-not-matched"
+	codeDeepScan   = "arch-deepscan"
+	codeCycle      = "arch-cycles"
+	codeExpired    = "arch-expired-exception"
+	codeStd        = "arch-std"
+	codeSymbol     = "arch-symbols"
+	codeNotice     = "arch-config"
+	codeExports    = "arch-exports"
+)
+
+type diagnostics map[string][]protocol.Diagnostic
+
+func (d diagnostics) add(file string, rng protocol.Range, severity models.Severity, code string, message string, data *protocol.DiagnosticData) {
+	uri := pathToURI(file)
+
+	d[uri] = append(d[uri], protocol.Diagnostic{
+		Range:    rng,
+		Severity: diagnosticSeverity(severity),
+		Code:     code,
+		Source:   serverName,
+		Message:  message,
+		Data:     data,
+	})
+}
+
+func noticesDiagnostics(notices []arch.Notice, archFile string) diagnostics {
+	list := diagnostics{}
+
+	for _, notice := range notices {
+		file := notice.Ref.File
+		if !notice.Ref.Valid || file == "" {
+			file = archFile
+		}
+
+		list.add(file, lineRange(notice.Ref), models.SeverityError, codeNotice, notice.Notice.Error(), nil)
+	}
+
+	return list
+}
+
+func warningsDiagnostics(spec arch.Spec, result models.CheckResult) diagnostics {
+	list := diagnostics{}
+
+	components := make(map[string]arch.Component, len(spec.Components))
+	for _, cmp := range spec.Components {
+		components[cmp.Name.Value] = cmp
+	}
+
+	for _, warn := range result.DependencyWarnings {
+		if warn.NotExportedBy != "" {
+			// mayDependOn will not help, so there is no quick fix
+			list.add(
+				warn.FileAbsolutePath,
+				importRange(warn.Reference, warn.ResolvedImportName),
+				warn.Severity,
+				codeExports,
+				fmt.Sprintf("Component %s shouldn't depend on %s (%s)", warn.ComponentName, warn.Target(), warn.Reason()),
+				nil,
+			)
+
+			continue
+		}
+
+		list.add(
+			warn.FileAbsolutePath,
+			importRange(warn.Reference, warn.ResolvedImportName),
+			warn.Severity,
+			codeDependency,
+			fmt.Sprintf("Component %s shouldn't depend on %s", warn.ComponentName, warn.Target()),
+			fixData(spec, components[warn.ComponentName], warn.ResolvedImportName),
+		)
+	}
+
+	for _, warn := range result.MatchWarnings {
+		list.add(
+			warn.FileAbsolutePath,
+			protocol.Range{},
+			warn.Severity,
+			codeMatch,
+			"File not attached to any component in archfile",
+			nil,
+		)
+	}
+
+	for _, warn := range result.DeepscanWarnings {
+		list.add(
+			warn.Dependency.Injection.File,
+			lineRange(warn.Dependency.Injection),
+			warn.Severity,
+			codeDeepScan,
+			fmt.Sprintf("Dependency %s -> %s not allowed (%s injected into %s)",
+				warn.Dependency.ComponentName,
+				warn.Gate.ComponentName,
+				warn.Dependency.Name,
+				warn.Gate.MethodName,
+			),
+			nil,
+		)
+	}
+
+	for _, warn := range result.ExpiredWarnings {
+		list.add(
+			warn.FileAbsolutePath,
+			importRange(warn.Reference, warn.ResolvedImportName),
+			warn.Severity,
+			codeExpired,
+			fmt.Sprintf("Component %s shouldn't depend on %s, exception expired at %s", warn.ComponentName, warn.ResolvedImportName, warn.Expires),
+			nil,
+		)
+	}
+
+	for _, warn := range result.StdWarnings {
+		list.add(
+			warn.FileAbsolutePath,
+			importRange(warn.Reference, warn.ResolvedImportName),
+			warn.Severity,
+			codeStd,
+			fmt.Sprintf("Component %s shouldn't use std package %s", warn.ComponentName, warn.ResolvedImportName),
+			nil,
+		)
+	}
+
+	for _, warn := range result.SymbolWarnings {
+		list.add(
+			warn.FileAbsolutePath,
+			symbolRange(warn.Reference, warn.Symbol),
+			warn.Severity,
+			codeSymbol,
+			fmt.Sprintf("Component %s shouldn't use %s (%s)", warn.ComponentName, warn.Symbol, warn.ResolvedImportName),
+			nil,
+		)
+	}
+
+	for _, warn := range result.CycleWarnings {
+		names := make([]string, 0, len(warn.Chain)+1)
+		for _, step := range warn.Chain {
+			names = append(names, step.ComponentName)
+		}
+
+		if len(warn.Chain) > 0 {
+			names = append(names, warn.Chain[len(warn.Chain)-1].DependOn)
+		}
+
+		for _, step := range warn.Chain {
+			list.add(
+				step.FileAbsolutePath,
+				importRange(step.Reference, step.ResolvedImportName),
+				warn.Severity,
+				codeCycle,
+				fmt.Sprintf("Import cycle between components %s", strings.Join(names, " -> ")),
+				nil,
+			)
+		}
+	}
+
+	return list
+}
+
+func diagnosticSeverity(severity models.Severity) protocol.DiagnosticSeverity {
+	switch severity {
+	case models.SeverityWarning:
+		return protocol.SeverityWarning
+	case models.SeverityInfo:
+		return protocol.SeverityInformation
+	default:
+		return protocol.SeverityError
+	}
+}
+
+// fixData is payload for "add mayDependOn" code action, it's
+// possible only for project imports, that not denied by rules
+func fixData(spec arch.Spec, component arch.Component, importPath string) *protocol.DiagnosticData {
+	target, found := importComponent(spec, importPath)
+	if !found {
+		return nil
+	}
+
+	for _, denied := range component.MayNotDependOn {
+		if denied.Value == target {
+			return nil
+		}
+	}
+
+	return &protocol.DiagnosticData{
+		ComponentName: component.Name.Value,
+		DependOn:      target,
+	}
+}
+
+// importRange highlight quoted import path
+func importRange(ref common.Reference, importPath string) protocol.Range {
+	if !ref.Valid {
+		return protocol.Range{}
+	}
+
+	start := protocol.Position{
+		Line:      maxInt(ref.Line-1, 0),
+		Character: maxInt(ref.Column-1, 0),
+	}
+
+	return protocol.Range{
+		Start: start,
+		End: protocol.Position{
+			Line:      start.Line,
+			Character: start.Character + len(importPath) + 2,
+		},
+	}
+}
+
+// symbolRange highlight selector expression (models.UserEntity)
+func symbolRange(ref common.Reference, symbol string) protocol.Range {
+	if !ref.Valid {
+		return protocol.Range{}
+	}
+
+	start := protocol.Position{
+		Line:      maxInt(ref.Line-1, 0),
+		Character: maxInt(ref.Column-1, 0),
+	}
+
+	return protocol.Range{
+		Start: start,
+		End: protocol.Position{
+			Line:      start.Line,
+			Character: start.Character + len(symbol),
+		},
+	}
+}
+
+// lineRange highlight all line from reference column
+func lineRange(ref common.Reference) protocol.Range {
+	if !ref.Valid {
+		return protocol.Range{}
+	}
+
+	line := maxInt(ref.Line-1, 0)
+
+	return protocol.Range{
+		Start: protocol.Position{Line: line, Character: maxInt(ref.Column-1, 0)},
+		End:   protocol.Position{Line: line + 1, Character: 0},
+	}
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,198 @@
+package lsp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	protocol "github.com/fe3dback/go-arch-lint/internal/models/lsp"
+	"github.com/stretchr/testify/assert"
+)
+
+func testSpec() arch.Spec {
+	component := func(name string, importPath string, mayNotDependOn ...string) arch.Component {
+		cmp := arch.Component{
+			Name: common.NewEmptyReferable(name),
+			ResolvedPaths: []common.Referable[models.ResolvedPath]{
+				common.NewEmptyReferable(models.ResolvedPath{ImportPath: importPath}),
+			},
+		}
+
+		for _, denied := range mayNotDependOn {
+			cmp.MayNotDependOn = append(cmp.MayNotDependOn, common.NewEmptyReferable(denied))
+		}
+
+		return cmp
+	}
+
+	return arch.Spec{
+		Components: []arch.Component{
+			component("handler", "example.com/app/handler", "repository"),
+			component("service", "example.com/app/service"),
+			component("repository", "example.com/app/repository"),
+		},
+	}
+}
+
+func TestNoticesDiagnostics(t *testing.T) {
+	notices := []arch.Notice{
+		{
+			Notice: fmt.Errorf("unknown component"),
+			Ref:    common.NewReferenceSingleLine("/app/arch/base.yml", 3, 5),
+		},
+		{
+			Notice: fmt.Errorf("broken document"),
+			Ref:    common.NewEmptyReference(),
+		},
+	}
+
+	got := noticesDiagnostics(notices, "/app/.go-arch-lint.yml")
+
+	assert.Equal(t, diagnostics{
+		"file:///app/arch/base.yml": {
+			{
+				Range: protocol.Range{
+					Start: protocol.Position{Line: 2, Character: 4},
+					End:   protocol.Position{Line: 3, Character: 0},
+				},
+				Severity: protocol.SeverityError,
+				Code:     codeNotice,
+				Source:   serverName,
+				Message:  "unknown component",
+			},
+		},
+		"file:///app/.go-arch-lint.yml": {
+			{
+				Severity: protocol.SeverityError,
+				Code:     codeNotice,
+				Source:   serverName,
+				Message:  "broken document",
+			},
+		},
+	}, got)
+}
+
+func TestWarningsDiagnostics(t *testing.T) {
+	dependency := func(component string, importPath string) models.CheckArchWarningDependency {
+		return models.CheckArchWarningDependency{
+			Severity:           models.SeverityError,
+			ComponentName:      component,
+			FileAbsolutePath:   "/app/" + component + "/file.go",
+			ResolvedImportName: importPath,
+			Reference:          common.NewReferenceSingleLine("/app/"+component+"/file.go", 5, 2),
+		}
+	}
+
+	notExported := dependency("service", "example.com/app/repository/internal")
+	notExported.NotExportedBy = "repository"
+
+	tests := []struct {
+		name   string
+		result models.CheckResult
+		want   diagnostics
+	}{
+		{
+			name: "dependency with quick fix",
+			result: models.CheckResult{
+				DependencyWarnings: []models.CheckArchWarningDependency{
+					dependency("service", "example.com/app/repository"),
+				},
+			},
+			want: diagnostics{
+				"file:///app/service/file.go": {
+					{
+						Range: protocol.Range{
+							Start: protocol.Position{Line: 4, Character: 1},
+							End:   protocol.Position{Line: 4, Character: 29},
+						},
+						Severity: protocol.SeverityError,
+						Code:     codeDependency,
+						Source:   serverName,
+						Message:  "Component service shouldn't depend on example.com/app/repository",
+						Data: &protocol.DiagnosticData{
+							ComponentName: "service",
+							DependOn:      "repository",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "dependency denied by mayNotDependOn",
+			result: models.CheckResult{
+				DependencyWarnings: []models.CheckArchWarningDependency{
+					dependency("handler", "example.com/app/repository"),
+				},
+			},
+			want: diagnostics{
+				"file:///app/handler/file.go": {
+					{
+						Range: protocol.Range{
+							Start: protocol.Position{Line: 4, Character: 1},
+							End:   protocol.Position{Line: 4, Character: 29},
+						},
+						Severity: protocol.SeverityError,
+						Code:     codeDependency,
+						Source:   serverName,
+						Message:  "Component handler shouldn't depend on example.com/app/repository",
+					},
+				},
+			},
+		},
+		{
+			name: "not exported package",
+			result: models.CheckResult{
+				DependencyWarnings: []models.CheckArchWarningDependency{notExported},
+			},
+			want: diagnostics{
+				"file:///app/service/file.go": {
+					{
+						Range: protocol.Range{
+							Start: protocol.Position{Line: 4, Character: 1},
+							End:   protocol.Position{Line: 4, Character: 38},
+						},
+						Severity: protocol.SeverityError,
+						Code:     codeExports,
+						Source:   serverName,
+						Message: "Component service shouldn't depend on example.com/app/repository/internal " +
+							"(package is not exported by component 'repository')",
+					},
+				},
+			},
+		},
+		{
+			name: "not matched file",
+			result: models.CheckResult{
+				MatchWarnings: []models.CheckArchWarningMatch{
+					{
+						Severity:         models.SeverityWarning,
+						FileAbsolutePath: "/app/main.go",
+					},
+				},
+			},
+			want: diagnostics{
+				"file:///app/main.go": {
+					{
+						Severity: protocol.SeverityWarning,
+						Code:     codeMatch,
+						Source:   serverName,
+						Message:  "File not attached to any component in archfile",
+					},
+				},
+			},
+		},
+		{
+			name:   "no warnings",
+			result: models.CheckResult{},
+			want:   diagnostics{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, warningsDiagnostics(testSpec(), tt.result))
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	protocol "github.com/fe3dback/go-arch-lint/internal/models/lsp"
+)
+
+const (
+	serverName = "go-arch-lint"
+
+	methodInitialize            = "initialize"
+	methodInitialized           = "initialized"
+	methodShutdown              = "shutdown"
+	methodDidOpen               = "textDocument/didOpen"
+	methodDidChange             = "textDocument/didChange"
+	methodDidClose              = "textDocument/didClose"
+	methodDidSave               = "textDocument/didSave"
+	methodDidChangeWatchedFiles = "workspace/didChangeWatchedFiles"
+	methodCodeAction            = "textDocument/codeAction"
+	methodPublishDiagnostics    = "textDocument/publishDiagnostics"
+)
+
+type (
+	Operation struct {
+		projectInfoAssembler projectInfoAssembler
+		specAssembler        specAssembler
+		specChecker          specChecker
+		archEditor           archEditor
+		lspServer            lspServer
+		version              string
+	}
+
+	// session is state of one client connection
+	session struct {
+		in          models.CmdLspIn
+		archFile    string
+		archFiles   map[string]struct{} // arch file and all included files
+		depsFiles   map[string]string   // component name -> arch file with its 'deps' rule
+		initialized bool
+		published   map[string]struct{}
+	}
+)
+
+func NewOperation(
+	projectInfoAssembler projectInfoAssembler,
+	specAssembler specAssembler,
+	specChecker specChecker,
+	archEditor archEditor,
+	lspServer lspServer,
+	version string,
+) *Operation {
+	return &Operation{
+		projectInfoAssembler: projectInfoAssembler,
+		specAssembler:        specAssembler,
+		specChecker:          specChecker,
+		archEditor:           archEditor,
+		lspServer:            lspServer,
+		version:              version,
+	}
+}
+
+func (o *Operation) Behave(ctx context.Context, in models.CmdLspIn, stdin io.Reader, stdout io.Writer) error {
+	sess := &session{
+		in:        in,
+		published: map[string]struct{}{},
+	}
+
+	err := o.lspServer.Serve(ctx, stdin, stdout, func(ctx context.Context, method string, params json.RawMessage, notify protocol.Notify) (any, error) {
+		return o.handle(ctx, sess, method, params, notify)
+	})
+	if err != nil {
+		return fmt.Errorf("language server failed: %w", err)
+	}
+
+	return nil
+}
+
+func (o *Operation) handle(
+	ctx context.Context,
+	sess *session,
+	method string,
+	params json.RawMessage,
+	notify protocol.Notify,
+) (any, error) {
+	switch method {
+	case methodInitialize:
+		initParams := protocol.InitializeParams{}
+		if err := json.Unmarshal(params, &initParams); err != nil {
+			return nil, protocol.NewInvalidParamsError(err)
+		}
+
+		return o.initialize(sess, initParams), nil
+	case methodInitialized:
+		return nil, o.publish(ctx, sess, notify)
+	case methodDidSave:
+		// linter read files from disk, so all changes
+		// will be visible only after saving
+		saveParams := protocol.DidSaveTextDocumentParams{}
+		if err := json.Unmarshal(params, &saveParams); err != nil {
+			return nil, protocol.NewInvalidParamsError(err)
+		}
+
+		if !isReloadRequired(sess, saveParams.TextDocument.URI) {
+			return nil, nil
+		}
+
+		return nil, o.publish(ctx, sess, notify)
+	case methodDidChangeWatchedFiles:
+		changeParams := protocol.DidChangeWatchedFilesParams{}
+		if err := json.Unmarshal(params, &changeParams); err != nil {
+			return nil, protocol.NewInvalidParamsError(err)
+		}
+
+		uris := make([]string, 0, len(changeParams.Changes))
+		for _, change := range changeParams.Changes {
+			uris = append(uris, change.URI)
+		}
+
+		if !isReloadRequired(sess, uris...) {
+			return nil, nil
+		}
+
+		return nil, o.publish(ctx, sess, notify)
+	case methodDidOpen, methodDidChange, methodDidClose:
+		return nil, nil
+	case methodCodeAction:
+		actionParams := protocol.CodeActionParams{}
+		if err := json.Unmarshal(params, &actionParams); err != nil {
+			return nil, protocol.NewInvalidParamsError(err)
+		}
+
+		return o.codeActions(sess, actionParams), nil
+	case methodShutdown:
+		return nil, nil
+	default:
+		return nil, protocol.NewMethodNotFoundError(method)
+	}
+}
+
+func (o *Operation) initialize(sess *session, params protocol.InitializeParams) protocol.InitializeResult {
+	if sess.in.ProjectPath == "" {
+		// project path from flag has priority over client workspace
+		sess.in.ProjectPath = models.DefaultProjectPath
+
+		if path, ok := uriToPath(params.RootURI); ok {
+			sess.in.ProjectPath = path
+		} else if params.RootPath != "" {
+			sess.in.ProjectPath = params.RootPath
+		}
+	}
+
+	sess.initialized = true
+
+	return protocol.InitializeResult{
+		Capabilities: protocol.ServerCapabilities{
+			TextDocumentSync: protocol.TextDocumentSyncOptions{
+				OpenClose: true,
+				Change:    protocol.TextDocumentSyncNone,
+				Save:      true,
+			},
+			CodeActionProvider: true,
+		},
+		ServerInfo: protocol.ServerInfo{
+			Name:    serverName,
+			Version: o.version,
+		},
+	}
+}
+
+// publish will check project and send all diagnostics to client,
+// files without warnings will be cleared from previous diagnostics
+func (o *Operation) publish(ctx context.Context, sess *session, notify protocol.Notify) error {
+	if !sess.initialized {
+		return nil
+	}
+
+	diagnostics, err := o.diagnostics(ctx, sess)
+	if err != nil {
+		return err
+	}
+
+	for uri := range sess.published {
+		if _, exist := diagnostics[uri]; !exist {
+			diagnostics[uri] = []protocol.Diagnostic{}
+		}
+	}
+
+	uris := make([]string, 0, len(diagnostics))
+	for uri := range diagnostics {
+		uris = append(uris, uri)
+	}
+
+	sort.Strings(uris)
+	sess.published = map[string]struct{}{}
+
+	for _, uri := range uris {
+		err = notify(methodPublishDiagnostics, protocol.PublishDiagnosticsParams{
+			URI:         uri,
+			Diagnostics: diagnostics[uri],
+		})
+		if err != nil {
+			return fmt.Errorf("failed to publish diagnostics: %w", err)
+		}
+
+		if len(diagnostics[uri]) > 0 {
+			sess.published[uri] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (o *Operation) diagnostics(ctx context.Context, sess *session) (map[string][]protocol.Diagnostic, error) {
+	projectInfo, err := o.projectInfoAssembler.ProjectInfo(sess.in.ProjectPath, sess.in.ArchFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	sess.archFile = projectInfo.GoArchFilePath
+
+	spec, err := o.specAssembler.Assemble(projectInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assemble spec: %w", err)
+	}
+
+	sess.archFiles = map[string]struct{}{projectInfo.GoArchFilePath: {}}
+	for _, includedFile := range spec.IncludedFiles {
+		sess.archFiles[includedFile] = struct{}{}
+	}
+
+	sess.depsFiles = map[string]string{}
+	for _, cmp := range spec.Components {
+		if cmp.DepsReference.Valid && cmp.DepsReference.File != "" {
+			sess.depsFiles[cmp.Name.Value] = cmp.DepsReference.File
+		}
+	}
+
+	if len(spec.Integrity.DocumentNotices) > 0 {
+		return noticesDiagnostics(spec.Integrity.DocumentNotices, projectInfo.GoArchFilePath), nil
+	}
+
+	result, err := o.specChecker.Check(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check project deps: %w", err)
+	}
+
+	return warningsDiagnostics(spec, result), nil
+}
+
+func (o *Operation) codeActions(sess *session, params protocol.CodeActionParams) []protocol.CodeAction {
+	actions := make([]protocol.CodeAction, 0)
+	if sess.archFile == "" {
+		return actions
+	}
+
+	for _, diagnostic := range params.Context.Diagnostics {
+		if diagnostic.Source != serverName || diagnostic.Data == nil {
+			continue
+		}
+
+		// component rules can be defined in included file, new
+		// rules without existing 'deps' is added into main arch file
+		archFile := sess.archFile
+		if depsFile, ok := sess.depsFiles[diagnostic.Data.ComponentName]; ok {
+			archFile = depsFile
+		}
+
+		edit, err := o.archEditor.AddMayDependOn(archFile, diagnostic.Data.ComponentName, diagnostic.Data.DependOn)
+		if err != nil {
+			// arch file format is not supported for auto edit,
+			// user can still fix it manually
+			continue
+		}
+
+		actions = append(actions, protocol.CodeAction{
+			Title: fmt.Sprintf("Allow component '%s' depend on '%s' (add to mayDependOn)",
+				diagnostic.Data.ComponentName,
+				diagnostic.Data.DependOn,
+			),
+			Kind:        protocol.CodeActionKindQuickFix,
+			Diagnostics: []protocol.Diagnostic{diagnostic},
+			Edit: &protocol.WorkspaceEdit{
+				Changes: map[string][]protocol.TextEdit{
+					pathToURI(archFile): {edit},
+				},
+			},
+		})
+	}
+
+	return actions
+}
+
+// isReloadRequired is true, when any of changed files can affect check
+// results: go code, go.mod, arch file or any of its included files
+func isReloadRequired(sess *session, uris ...string) bool {
+	if sess.archFiles == nil {
+		// project not checked yet
+		return true
+	}
+
+	for _, uri := range uris {
+		path, ok := uriToPath(uri)
+		if !ok {
+			continue
+		}
+
+		if filepath.Ext(path) == ".go" || filepath.Base(path) == "go.mod" {
+			return true
+		}
+
+		if _, ok := sess.archFiles[filepath.Clean(path)]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// importComponent find component, that own imported package
+func importComponent(spec arch.Spec, importPath string) (string, bool) {
+	for _, cmp := range spec.Components {
+		for _, resolvedPath := range cmp.ResolvedPaths {
+			if resolvedPath.Value.ImportPath == importPath {
+				return cmp.Name.Value, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func pathToURI(path string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
+
+func uriToPath(uri string) (string, bool) {
+	if !strings.HasPrefix(uri, "file://") {
+		return "", false
+	}
+
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", false
+	}
+
+	return filepath.FromSlash(parsed.Path), true
+}
@@ -0,0 +1,139 @@
+package lsp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	protocol "github.com/fe3dback/go-arch-lint/internal/models/lsp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	fakeProjectInfoAssembler struct{}
+
+	fakeSpecAssembler struct {
+		spec arch.Spec
+	}
+
+	fakeSpecChecker struct {
+		results []models.CheckResult
+	}
+
+	fakeArchEditor struct {
+		files []string
+	}
+)
+
+func (f *fakeProjectInfoAssembler) ProjectInfo(_ string, _ string) (common.Project, error) {
+	return common.Project{GoArchFilePath: "/app/.go-arch-lint.yml"}, nil
+}
+
+func (f *fakeSpecAssembler) Assemble(_ common.Project) (arch.Spec, error) {
+	return f.spec, nil
+}
+
+func (f *fakeSpecChecker) Check(_ context.Context, _ arch.Spec) (models.CheckResult, error) {
+	result := f.results[0]
+	f.results = f.results[1:]
+
+	return result, nil
+}
+
+func (f *fakeArchEditor) AddMayDependOn(archFilePath string, _ string, _ string) (protocol.TextEdit, error) {
+	f.files = append(f.files, archFilePath)
+
+	return protocol.TextEdit{NewText: "edit"}, nil
+}
+
+func TestOperation_publish(t *testing.T) {
+	warning := models.CheckArchWarningMatch{
+		Severity:         models.SeverityWarning,
+		FileAbsolutePath: "/app/main.go",
+	}
+
+	checker := &fakeSpecChecker{
+		results: []models.CheckResult{
+			{MatchWarnings: []models.CheckArchWarningMatch{warning}},
+			{},
+			{},
+		},
+	}
+
+	op := NewOperation(&fakeProjectInfoAssembler{}, &fakeSpecAssembler{}, checker, &fakeArchEditor{}, nil, "dev")
+	sess := &session{initialized: true, published: map[string]struct{}{}}
+
+	var sent []protocol.PublishDiagnosticsParams
+	notify := func(method string, params any) error {
+		assert.Equal(t, methodPublishDiagnostics, method)
+		sent = append(sent, params.(protocol.PublishDiagnosticsParams))
+
+		return nil
+	}
+
+	// warning published
+	require.NoError(t, op.publish(context.Background(), sess, notify))
+	require.Len(t, sent, 1)
+	assert.Equal(t, "file:///app/main.go", sent[0].URI)
+	assert.Len(t, sent[0].Diagnostics, 1)
+
+	// warning is fixed, file diagnostics should be cleared
+	sent = nil
+	require.NoError(t, op.publish(context.Background(), sess, notify))
+	require.Len(t, sent, 1)
+	assert.Equal(t, "file:///app/main.go", sent[0].URI)
+	assert.Empty(t, sent[0].Diagnostics)
+
+	// nothing to clear anymore
+	sent = nil
+	require.NoError(t, op.publish(context.Background(), sess, notify))
+	assert.Empty(t, sent)
+}
+
+func TestOperation_codeActions(t *testing.T) {
+	diagnostic := func(component string, data bool) protocol.Diagnostic {
+		diag := protocol.Diagnostic{
+			Code:   codeDependency,
+			Source: serverName,
+		}
+
+		if data {
+			diag.Data = &protocol.DiagnosticData{ComponentName: component, DependOn: "repository"}
+		}
+
+		return diag
+	}
+
+	editor := &fakeArchEditor{}
+	op := NewOperation(&fakeProjectInfoAssembler{}, &fakeSpecAssembler{}, &fakeSpecChecker{}, editor, nil, "dev")
+	sess := &session{
+		archFile: "/app/.go-arch-lint.yml",
+		depsFiles: map[string]string{
+			"service": "/app/arch/service.yml",
+		},
+	}
+
+	foreign := diagnostic("handler", true)
+	foreign.Source = "gopls"
+
+	actions := op.codeActions(sess, protocol.CodeActionParams{
+		Context: protocol.CodeActionContext{
+			Diagnostics: []protocol.Diagnostic{
+				diagnostic("service", true),
+				diagnostic("handler", true),
+				diagnostic("handler", false),
+				foreign,
+			},
+		},
+	})
+
+	// deps of service defined in included file, handler
+	// don't have deps, so it will be added into main file
+	assert.Equal(t, []string{"/app/arch/service.yml", "/app/.go-arch-lint.yml"}, editor.files)
+	require.Len(t, actions, 2)
+	assert.Contains(t, actions[0].Edit.Changes, "file:///app/arch/service.yml")
+	assert.Contains(t, actions[1].Edit.Changes, "file:///app/.go-arch-lint.yml")
+}
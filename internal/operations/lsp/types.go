@@ -0,0 +1,33 @@
+package lsp
+
+import (
+	"context"
+	"io"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	protocol "github.com/fe3dback/go-arch-lint/internal/models/lsp"
+)
+
+type (
+	projectInfoAssembler interface {
+		ProjectInfo(rootDirectory string, archFilePath string) (common.Project, error)
+	}
+
+	specAssembler interface {
+		Assemble(prj common.Project) (arch.Spec, error)
+	}
+
+	specChecker interface {
+		Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error)
+	}
+
+	archEditor interface {
+		AddMayDependOn(archFilePath string, componentName string, dependOn string) (protocol.TextEdit, error)
+	}
+
+	lspServer interface {
+		Serve(ctx context.Context, in io.Reader, out io.Writer, handler protocol.Handler) error
+	}
+)
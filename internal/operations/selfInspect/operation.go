@@ -2,6 +2,8 @@ package selfInspect
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
@@ -45,9 +47,45 @@ func (o *Operation) Behave(in models.CmdSelfInspectIn) (models.CmdSelfInspectOut
 		LinterVersion: o.version,
 		Notices:       o.extractNotices(&spec),
 		Suggestions:   o.extractSuggestions(&spec),
+		Exceptions:    o.extractExceptions(&spec, time.Now()),
 	}, nil
 }
 
+// extractExceptions returns all exceptions, closest expirations first
+func (o *Operation) extractExceptions(spec *arch.Spec, now time.Time) []models.CmdSelfInspectOutException {
+	exceptions := make([]models.CmdSelfInspectOutException, 0)
+
+	for _, cmp := range spec.Components {
+		for _, exception := range cmp.Exceptions {
+			exceptions = append(exceptions, models.CmdSelfInspectOutException{
+				ComponentName: cmp.Name.Value,
+				Target:        exception.Target.Value,
+				Reason:        exception.Reason.Value,
+				Owner:         exception.Owner.Value,
+				Ticket:        exception.Ticket.Value,
+				Expires:       exception.Expires.Value.Format(models.ExceptionDateLayout),
+				Expired:       exception.IsExpired(now),
+				Reference:     exception.Expires.Reference,
+			})
+		}
+	}
+
+	// dates in YYYY-MM-DD format can be compared as strings
+	sort.SliceStable(exceptions, func(i, j int) bool {
+		if exceptions[i].Expires != exceptions[j].Expires {
+			return exceptions[i].Expires < exceptions[j].Expires
+		}
+
+		if exceptions[i].ComponentName != exceptions[j].ComponentName {
+			return exceptions[i].ComponentName < exceptions[j].ComponentName
+		}
+
+		return exceptions[i].Target < exceptions[j].Target
+	})
+
+	return exceptions
+}
+
 func (o *Operation) extractNotices(spec *arch.Spec) []models.CmdSelfInspectOutAnnotation {
 	return o.asAnnotations(spec.Integrity.DocumentNotices)
 }
@@ -0,0 +1,188 @@
+package unused
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type Operation struct {
+	specAssembler        specAssembler
+	projectFilesResolver projectFilesResolver
+	projectInfoAssembler projectInfoAssembler
+}
+
+func NewOperation(
+	specAssembler specAssembler,
+	projectFilesResolver projectFilesResolver,
+	projectInfoAssembler projectInfoAssembler,
+) *Operation {
+	return &Operation{
+		specAssembler:        specAssembler,
+		projectFilesResolver: projectFilesResolver,
+		projectInfoAssembler: projectInfoAssembler,
+	}
+}
+
+func (o *Operation) Behave(ctx context.Context, in models.CmdUnusedIn) (models.CmdUnusedOut, error) {
+	projectInfo, err := o.projectInfoAssembler.ProjectInfo(in.ProjectPath, in.ArchFile)
+	if err != nil {
+		return models.CmdUnusedOut{}, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	spec, err := o.specAssembler.Assemble(projectInfo)
+	if err != nil {
+		return models.CmdUnusedOut{}, fmt.Errorf("failed to assemble spec: %w", err)
+	}
+
+	if len(spec.Integrity.DocumentNotices) > 0 {
+		return models.CmdUnusedOut{}, fmt.Errorf("arch file has %d notices, run 'check' command for details",
+			len(spec.Integrity.DocumentNotices),
+		)
+	}
+
+	projectFiles, err := o.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return models.CmdUnusedOut{}, fmt.Errorf("failed to resolve project files: %w", err)
+	}
+
+	unused, err := findUnused(spec, projectFiles)
+	if err != nil {
+		return models.CmdUnusedOut{}, fmt.Errorf("failed to find unused deps: %w", err)
+	}
+
+	model := models.CmdUnusedOut{
+		ModuleName: spec.ModuleName.Value,
+		Unused:     unused,
+	}
+
+	if len(unused) > 0 {
+		// normal output with exit code 1
+		return model, models.NewUserSpaceError("found unused deps rules")
+	}
+
+	return model, nil
+}
+
+// findUnused compare every allowed edge (mayDependOn, canUse) with
+// real imports of component files
+func findUnused(spec arch.Spec, projectFiles []models.FileHold) ([]models.CmdUnusedOutRule, error) {
+	componentImports := make(map[string]map[string]models.ResolvedImport)
+	for _, projectFile := range projectFiles {
+		if projectFile.ComponentID == nil {
+			continue
+		}
+
+		componentID := *projectFile.ComponentID
+		if _, exist := componentImports[componentID]; !exist {
+			componentImports[componentID] = make(map[string]models.ResolvedImport)
+		}
+
+		for _, resolvedImport := range projectFile.File.Imports {
+			componentImports[componentID][resolvedImport.Name] = resolvedImport
+		}
+	}
+
+	componentPackages := make(map[string][]string)
+	for _, cmp := range spec.Components {
+		for _, resolvedPath := range cmp.ResolvedPaths {
+			componentPackages[cmp.Name.Value] = append(componentPackages[cmp.Name.Value], resolvedPath.Value.ImportPath)
+		}
+	}
+
+	vendorGlobs := make(map[string][]models.VendorGlob)
+	for _, vendor := range spec.Vendors {
+		for _, glob := range vendor.ImportGlobs {
+			vendorGlobs[vendor.Name.Value] = append(vendorGlobs[vendor.Name.Value], glob.Value)
+		}
+	}
+
+	// deps generated from layers is not explicit rules, so
+	// they are not reported, even if not used
+	layerRefs := make(map[common.Reference]struct{}, len(spec.Layers))
+	for _, layer := range spec.Layers {
+		layerRefs[layer.Reference] = struct{}{}
+	}
+
+	unused := make([]models.CmdUnusedOutRule, 0)
+
+	for _, cmp := range spec.Components {
+		imports := componentImports[cmp.Name.Value]
+
+		for _, dep := range cmp.MayDependOn {
+			if _, fromLayer := layerRefs[dep.Reference]; fromLayer {
+				continue
+			}
+
+			if usedAnyOf(imports, componentPackages[dep.Value]) {
+				continue
+			}
+
+			unused = append(unused, models.CmdUnusedOutRule{
+				ComponentName:  cmp.Name.Value,
+				Kind:           models.UnusedKindComponent,
+				DependencyName: dep.Value,
+				Reference:      dep.Reference,
+			})
+		}
+
+		for _, vnd := range cmp.CanUse {
+			used, err := usedAnyGlob(imports, vendorGlobs[vnd.Value])
+			if err != nil {
+				return nil, fmt.Errorf("failed match vendor '%s': %w", vnd.Value, err)
+			}
+
+			if used {
+				continue
+			}
+
+			unused = append(unused, models.CmdUnusedOutRule{
+				ComponentName:  cmp.Name.Value,
+				Kind:           models.UnusedKindVendor,
+				DependencyName: vnd.Value,
+				Reference:      vnd.Reference,
+			})
+		}
+	}
+
+	sort.Slice(unused, func(i, j int) bool {
+		if unused[i].Reference.File != unused[j].Reference.File {
+			return unused[i].Reference.File < unused[j].Reference.File
+		}
+
+		return unused[i].Reference.Line < unused[j].Reference.Line
+	})
+
+	return unused, nil
+}
+
+func usedAnyOf(imports map[string]models.ResolvedImport, packages []string) bool {
+	for _, importPath := range packages {
+		if _, used := imports[importPath]; used {
+			return true
+		}
+	}
+
+	return false
+}
+
+func usedAnyGlob(imports map[string]models.ResolvedImport, globs []models.VendorGlob) (bool, error) {
+	for _, resolvedImport := range imports {
+		for _, glob := range globs {
+			matched, err := glob.Match(resolvedImport)
+			if err != nil {
+				return false, fmt.Errorf("invalid vendor glob '%s': %w", glob, err)
+			}
+
+			if matched {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
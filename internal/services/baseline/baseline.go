@@ -0,0 +1,316 @@
+package baseline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+// baseline file format version, increment on breaking changes in fingerprint algorithm
+const documentVersion = 1
+
+type (
+	Baseline struct{}
+
+	document struct {
+		Version int                         `json:"Version"`
+		Entries []models.CheckBaselineEntry `json:"Entries"`
+	}
+)
+
+func NewBaseline() *Baseline {
+	return &Baseline{}
+}
+
+func (b *Baseline) Read(filePath string) ([]models.CheckBaselineEntry, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("baseline file '%s' not exist, create it with '--write-baseline' flag", filePath)
+		}
+
+		return nil, fmt.Errorf("failed read baseline '%s': %w", filePath, err)
+	}
+
+	doc := document{}
+	err = json.Unmarshal(content, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed decode baseline '%s': %w", filePath, err)
+	}
+
+	if doc.Version != documentVersion {
+		return nil, fmt.Errorf("baseline '%s' has unsupported version %d (expected %d), rewrite it with '--write-baseline' flag",
+			filePath,
+			doc.Version,
+			documentVersion,
+		)
+	}
+
+	return doc.Entries, nil
+}
+
+func (b *Baseline) Write(filePath string, entries []models.CheckBaselineEntry) error {
+	sorted := make([]models.CheckBaselineEntry, len(entries))
+	copy(sorted, entries)
+
+	// stable order, so baseline diffs in VCS show only real changes
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].File != sorted[j].File {
+			return sorted[i].File < sorted[j].File
+		}
+
+		return sorted[i].Fingerprint < sorted[j].Fingerprint
+	})
+
+	content, err := json.MarshalIndent(document{
+		Version: documentVersion,
+		Entries: sorted,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed encode baseline: %w", err)
+	}
+
+	err = os.WriteFile(filePath, append(content, '\n'), 0o644)
+	if err != nil {
+		return fmt.Errorf("failed write baseline into '%s': %w", filePath, err)
+	}
+
+	return nil
+}
+
+// Entries convert all check warnings into baseline entries
+func (b *Baseline) Entries(result models.CheckResult, rootDirectory string) []models.CheckBaselineEntry {
+	entries := make([]models.CheckBaselineEntry, 0)
+
+	for _, warn := range result.DependencyWarnings {
+		entries = append(entries, b.dependencyEntry(warn, rootDirectory))
+	}
+
+	for _, warn := range result.MatchWarnings {
+		entries = append(entries, b.matchEntry(warn, rootDirectory))
+	}
+
+	for _, warn := range result.DeepscanWarnings {
+		entries = append(entries, b.deepscanEntry(warn, rootDirectory))
+	}
+
+	for _, warn := range result.CycleWarnings {
+		entries = append(entries, b.cycleEntry(warn))
+	}
+
+	for _, warn := range result.ExpiredWarnings {
+		entries = append(entries, b.expiredEntry(warn, rootDirectory))
+	}
+
+	for _, warn := range result.StdWarnings {
+		entries = append(entries, b.stdEntry(warn, rootDirectory))
+	}
+
+	for _, warn := range result.SymbolWarnings {
+		entries = append(entries, b.symbolEntry(warn, rootDirectory))
+	}
+
+	return entries
+}
+
+// Apply will remove all known (baselined) warnings from result.
+// Returns only new warnings, count of suppressed warnings and list of
+// baseline entries that not exist anymore in code (already fixed).
+func (b *Baseline) Apply(
+	result models.CheckResult,
+	known []models.CheckBaselineEntry,
+	rootDirectory string,
+) (models.CheckResult, int, []models.CheckBaselineEntry) {
+	// same warning can be found more than once (for example deepscan
+	// injections in one file), so count every fingerprint
+	pending := make(map[string]int, len(known))
+	for _, entry := range known {
+		pending[entry.Fingerprint]++
+	}
+
+	suppressedCount := 0
+	isKnown := func(entry models.CheckBaselineEntry) bool {
+		if pending[entry.Fingerprint] <= 0 {
+			return false
+		}
+
+		pending[entry.Fingerprint]--
+		suppressedCount++
+		return true
+	}
+
+	filtered := models.CheckResult{
+		DependencyWarnings: []models.CheckArchWarningDependency{},
+		MatchWarnings:      []models.CheckArchWarningMatch{},
+		DeepscanWarnings:   []models.CheckArchWarningDeepscan{},
+		CycleWarnings:      []models.CheckArchWarningCycle{},
+		ExpiredWarnings:    []models.CheckArchWarningExpired{},
+		StdWarnings:        []models.CheckArchWarningStd{},
+		SymbolWarnings:     []models.CheckArchWarningSymbol{},
+
+		// suppressed by comments in code, not by baseline
+		SuppressedWarnings: result.SuppressedWarnings,
+		Suppressions:       result.Suppressions,
+		Interrupted:        result.Interrupted,
+	}
+
+	for _, warn := range result.DependencyWarnings {
+		if !isKnown(b.dependencyEntry(warn, rootDirectory)) {
+			filtered.DependencyWarnings = append(filtered.DependencyWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.MatchWarnings {
+		if !isKnown(b.matchEntry(warn, rootDirectory)) {
+			filtered.MatchWarnings = append(filtered.MatchWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.DeepscanWarnings {
+		if !isKnown(b.deepscanEntry(warn, rootDirectory)) {
+			filtered.DeepscanWarnings = append(filtered.DeepscanWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.CycleWarnings {
+		if !isKnown(b.cycleEntry(warn)) {
+			filtered.CycleWarnings = append(filtered.CycleWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.ExpiredWarnings {
+		if !isKnown(b.expiredEntry(warn, rootDirectory)) {
+			filtered.ExpiredWarnings = append(filtered.ExpiredWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.StdWarnings {
+		if !isKnown(b.stdEntry(warn, rootDirectory)) {
+			filtered.StdWarnings = append(filtered.StdWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.SymbolWarnings {
+		if !isKnown(b.symbolEntry(warn, rootDirectory)) {
+			filtered.SymbolWarnings = append(filtered.SymbolWarnings, warn)
+		}
+	}
+
+	fixed := make([]models.CheckBaselineEntry, 0)
+	for _, entry := range known {
+		if pending[entry.Fingerprint] <= 0 {
+			continue
+		}
+
+		pending[entry.Fingerprint]--
+		fixed = append(fixed, entry)
+	}
+
+	return filtered, suppressedCount, fixed
+}
+
+func (b *Baseline) dependencyEntry(warn models.CheckArchWarningDependency, rootDirectory string) models.CheckBaselineEntry {
+	if warn.Module != "" {
+		// undeclared module reported once per component by first found
+		// import, so file can be changed without fixing the import itself
+		return newEntry(
+			models.BaselineKindDependency,
+			warn.ComponentName,
+			"",
+			warn.Module,
+		)
+	}
+
+	return newEntry(
+		models.BaselineKindDependency,
+		warn.ComponentName,
+		relativePath(warn.FileAbsolutePath, rootDirectory),
+		warn.ResolvedImportName,
+	)
+}
+
+func (b *Baseline) matchEntry(warn models.CheckArchWarningMatch, rootDirectory string) models.CheckBaselineEntry {
+	return newEntry(
+		models.BaselineKindMatch,
+		"",
+		relativePath(warn.FileAbsolutePath, rootDirectory),
+		"",
+	)
+}
+
+func (b *Baseline) deepscanEntry(warn models.CheckArchWarningDeepscan, rootDirectory string) models.CheckBaselineEntry {
+	return newEntry(
+		models.BaselineKindDeepScan,
+		warn.Gate.ComponentName,
+		relativePath(warn.Dependency.Injection.File, rootDirectory),
+		fmt.Sprintf("%s(%s: %s)",
+			warn.Gate.MethodName,
+			warn.Dependency.ComponentName,
+			warn.Dependency.Name,
+		),
+	)
+}
+
+// cycle is identified only by components group, chain files
+// can be changed without fixing the cycle itself
+func (b *Baseline) cycleEntry(warn models.CheckArchWarningCycle) models.CheckBaselineEntry {
+	return newEntry(
+		models.BaselineKindCycle,
+		"",
+		"",
+		strings.Join(warn.ComponentNames, " -> "),
+	)
+}
+
+func (b *Baseline) expiredEntry(warn models.CheckArchWarningExpired, rootDirectory string) models.CheckBaselineEntry {
+	return newEntry(
+		models.BaselineKindExpired,
+		warn.ComponentName,
+		relativePath(warn.FileAbsolutePath, rootDirectory),
+		warn.ResolvedImportName,
+	)
+}
+
+func (b *Baseline) stdEntry(warn models.CheckArchWarningStd, rootDirectory string) models.CheckBaselineEntry {
+	return newEntry(
+		models.BaselineKindStd,
+		warn.ComponentName,
+		relativePath(warn.FileAbsolutePath, rootDirectory),
+		warn.ResolvedImportName,
+	)
+}
+
+func (b *Baseline) symbolEntry(warn models.CheckArchWarningSymbol, rootDirectory string) models.CheckBaselineEntry {
+	return newEntry(
+		models.BaselineKindSymbol,
+		warn.ComponentName,
+		relativePath(warn.FileAbsolutePath, rootDirectory),
+		fmt.Sprintf("%s: %s", warn.ResolvedImportName, warn.Symbol),
+	)
+}
+
+// fingerprint not include line numbers, because baseline should
+// survive unrelated code changes in same file
+func newEntry(kind models.BaselineKind, component, file, target string) models.CheckBaselineEntry {
+	hash := sha256.Sum256([]byte(strings.Join([]string{kind, component, file, target}, "\n")))
+
+	return models.CheckBaselineEntry{
+		Fingerprint: hex.EncodeToString(hash[:])[:16],
+		Kind:        kind,
+		Component:   component,
+		File:        file,
+		Target:      target,
+	}
+}
+
+func relativePath(absPath string, rootDirectory string) string {
+	return filepath.ToSlash(strings.TrimPrefix(absPath, rootDirectory))
+}
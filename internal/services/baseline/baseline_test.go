@@ -0,0 +1,169 @@
+package baseline
+
+import (
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const testRootDirectory = "/app"
+
+func makeTestDependencyWarning(file string, importName string) models.CheckArchWarningDependency {
+	return models.CheckArchWarningDependency{
+		ComponentName:      "cmp",
+		FileRelativePath:   file,
+		FileAbsolutePath:   testRootDirectory + file,
+		ResolvedImportName: importName,
+	}
+}
+
+func makeTestMatchWarning(file string) models.CheckArchWarningMatch {
+	return models.CheckArchWarningMatch{
+		FileRelativePath: file,
+		FileAbsolutePath: testRootDirectory + file,
+	}
+}
+
+func TestBaseline_Apply(t *testing.T) {
+	b := NewBaseline()
+
+	known := b.Entries(models.CheckResult{
+		DependencyWarnings: []models.CheckArchWarningDependency{
+			makeTestDependencyWarning("/a/a.go", "example.com/b"),
+			makeTestDependencyWarning("/a/a.go", "example.com/c"),
+		},
+		MatchWarnings: []models.CheckArchWarningMatch{
+			makeTestMatchWarning("/nc/nc.go"),
+		},
+	}, testRootDirectory)
+
+	tests := []struct {
+		name           string
+		result         models.CheckResult
+		wantDeps       []models.CheckArchWarningDependency
+		wantMatch      []models.CheckArchWarningMatch
+		wantSuppressed int
+		wantFixed      []string
+	}{
+		{
+			name: "all known",
+			result: models.CheckResult{
+				DependencyWarnings: []models.CheckArchWarningDependency{
+					makeTestDependencyWarning("/a/a.go", "example.com/b"),
+					makeTestDependencyWarning("/a/a.go", "example.com/c"),
+				},
+				MatchWarnings: []models.CheckArchWarningMatch{
+					makeTestMatchWarning("/nc/nc.go"),
+				},
+			},
+			wantDeps:       []models.CheckArchWarningDependency{},
+			wantMatch:      []models.CheckArchWarningMatch{},
+			wantSuppressed: 3,
+			wantFixed:      []string{},
+		},
+		{
+			name: "new and fixed",
+			result: models.CheckResult{
+				DependencyWarnings: []models.CheckArchWarningDependency{
+					makeTestDependencyWarning("/a/a.go", "example.com/b"),
+					makeTestDependencyWarning("/a/a.go", "example.com/d"),
+				},
+			},
+			wantDeps: []models.CheckArchWarningDependency{
+				makeTestDependencyWarning("/a/a.go", "example.com/d"),
+			},
+			wantMatch:      []models.CheckArchWarningMatch{},
+			wantSuppressed: 1,
+			wantFixed:      []string{"example.com/c", ""},
+		},
+		{
+			name: "duplicate suppressed only once",
+			result: models.CheckResult{
+				MatchWarnings: []models.CheckArchWarningMatch{
+					makeTestMatchWarning("/nc/nc.go"),
+					makeTestMatchWarning("/nc/nc.go"),
+				},
+			},
+			wantDeps: []models.CheckArchWarningDependency{},
+			wantMatch: []models.CheckArchWarningMatch{
+				makeTestMatchWarning("/nc/nc.go"),
+			},
+			wantSuppressed: 1,
+			wantFixed:      []string{"example.com/b", "example.com/c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered, suppressed, fixed := b.Apply(tt.result, known, testRootDirectory)
+
+			fixedTargets := make([]string, 0, len(fixed))
+			for _, entry := range fixed {
+				fixedTargets = append(fixedTargets, entry.Target)
+			}
+
+			assert.Equal(t, tt.wantDeps, filtered.DependencyWarnings)
+			assert.Equal(t, tt.wantMatch, filtered.MatchWarnings)
+			assert.Equal(t, tt.wantSuppressed, suppressed)
+			assert.Equal(t, tt.wantFixed, fixedTargets)
+		})
+	}
+}
+
+func TestBaseline_Apply_keepSuppressions(t *testing.T) {
+	b := NewBaseline()
+
+	result := models.CheckResult{
+		DependencyWarnings: []models.CheckArchWarningDependency{
+			makeTestDependencyWarning("/a/a.go", "example.com/b"),
+		},
+		SuppressedWarnings: []models.CheckSuppressedWarning{
+			{Kind: models.BaselineKindDependency, ComponentName: "a", Target: "example.com/c", Reason: "legacy"},
+		},
+		Suppressions: []models.CheckSuppression{
+			{ComponentName: "a", Reason: "legacy"},
+		},
+	}
+
+	filtered, _, _ := b.Apply(result, b.Entries(result, testRootDirectory), testRootDirectory)
+
+	assert.Empty(t, filtered.DependencyWarnings)
+	assert.Equal(t, result.SuppressedWarnings, filtered.SuppressedWarnings)
+	assert.Equal(t, result.Suppressions, filtered.Suppressions)
+}
+
+func Test_newEntry_notDependOnRoot(t *testing.T) {
+	b := NewBaseline()
+
+	a := b.dependencyEntry(models.CheckArchWarningDependency{
+		ComponentName:      "cmp",
+		FileAbsolutePath:   "/home/a/project/internal/a.go",
+		ResolvedImportName: "example.com/b",
+	}, "/home/a/project")
+
+	c := b.dependencyEntry(models.CheckArchWarningDependency{
+		ComponentName:      "cmp",
+		FileAbsolutePath:   "/ci/build/internal/a.go",
+		ResolvedImportName: "example.com/b",
+	}, "/ci/build")
+
+	assert.Equal(t, a, c)
+	assert.Equal(t, "/internal/a.go", a.File)
+}
+
+func Test_dependencyEntry_moduleNotDependOnFile(t *testing.T) {
+	b := NewBaseline()
+
+	first := makeTestDependencyWarning("/a/a.go", "example.com/lib/a")
+	first.Module = "example.com/lib"
+
+	moved := makeTestDependencyWarning("/a/b.go", "example.com/lib/b")
+	moved.Module = "example.com/lib"
+
+	a := b.dependencyEntry(first, testRootDirectory)
+	c := b.dependencyEntry(moved, testRootDirectory)
+
+	assert.Equal(t, a, c)
+	assert.Equal(t, "example.com/lib", a.Target)
+	assert.Equal(t, "", a.File)
+}
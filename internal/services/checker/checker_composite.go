@@ -17,9 +17,25 @@ func NewCompositeChecker(checkers ...checker) *CompositeChecker {
 }
 
 func (c *CompositeChecker) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
+	return c.CheckFiltered(ctx, spec, nil)
+}
+
+// CheckFiltered is same as Check, but result of every checker is passed
+// through filter before deciding to stop next checks. Filter should hide
+// warnings that will be not reported anyway (baselined), so known legacy
+// violations not stop next checkers. Returned result is not filtered.
+func (c *CompositeChecker) CheckFiltered(
+	ctx context.Context,
+	spec arch.Spec,
+	filter func(models.CheckResult) models.CheckResult,
+) (models.CheckResult, error) {
 	overallResults := models.CheckResult{}
 
 	for ind, checker := range c.checkers {
+		if err := ctx.Err(); err != nil {
+			return models.CheckResult{}, err
+		}
+
 		results, err := checker.Check(ctx, spec)
 		if err != nil {
 			return models.CheckResult{}, fmt.Errorf("checker failed '%T': %w", checker, err)
@@ -27,7 +43,14 @@ func (c *CompositeChecker) Check(ctx context.Context, spec arch.Spec) (models.Ch
 
 		overallResults.Append(results)
 
-		if results.HasNotices() && ind < len(c.checkers)-1 {
+		if filter != nil {
+			results = filter(results)
+		}
+
+		// warnings with lower severity than error not stop next checks,
+		// so deepscan still can run, when vendor imports is just warnings
+		if results.HasWarningsAtLeast(models.SeverityError) && ind < len(c.checkers)-1 {
+			overallResults.Interrupted = true
 			break
 		}
 	}
@@ -0,0 +1,162 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/cycles"
+)
+
+type Cycles struct {
+	projectFilesResolver projectFilesResolver
+}
+
+func NewCycles(
+	projectFilesResolver projectFilesResolver,
+) *Cycles {
+	return &Cycles{
+		projectFilesResolver: projectFilesResolver,
+	}
+}
+
+func (c *Cycles) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
+	result := models.CheckResult{
+		CycleWarnings: []models.CheckArchWarningCycle{},
+	}
+
+	if !spec.Allow.DetectCycles.Value {
+		return result, nil
+	}
+
+	projectFiles, err := c.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return models.CheckResult{}, fmt.Errorf("failed to resolve project files: %w", err)
+	}
+
+	edges := c.componentEdges(spec, projectFiles)
+	graph := make(cycles.Graph, len(edges))
+	for from, to := range edges {
+		for dependOn := range to {
+			graph[from] = append(graph[from], dependOn)
+		}
+	}
+
+	for _, componentNames := range cycles.StronglyConnected(graph) {
+		chain := cycles.Chain(graph, componentNames)
+		steps := make([]models.CheckArchWarningCycleStep, 0, len(chain))
+
+		for ind := 0; ind < len(chain)-1; ind++ {
+			steps = append(steps, edges[chain[ind]][chain[ind+1]])
+		}
+
+		result.CycleWarnings = append(result.CycleWarnings, models.CheckArchWarningCycle{
+			Severity:       c.cycleSeverity(spec, componentNames),
+			ComponentNames: componentNames,
+			Chain:          steps,
+		})
+	}
+
+	return result, nil
+}
+
+// cycleSeverity is most important level of all components in cycle
+func (c *Cycles) cycleSeverity(spec arch.Spec, componentNames []string) models.Severity {
+	inCycle := make(map[string]struct{}, len(componentNames))
+	for _, name := range componentNames {
+		inCycle[name] = struct{}{}
+	}
+
+	severity := models.SeverityInfo
+	for _, cmp := range spec.Components {
+		if _, ok := inCycle[cmp.Name.Value]; !ok {
+			continue
+		}
+
+		if cmpSeverity := cmp.Severity.Cycles.Value; models.SeverityAtLeast(cmpSeverity, severity) {
+			severity = cmpSeverity
+		}
+	}
+
+	return severity
+}
+
+// componentEdges build real component-level import graph, every edge
+// hold first found import (by file path), that depend one component on another
+func (c *Cycles) componentEdges(
+	spec arch.Spec,
+	projectFiles []models.FileHold,
+) map[string]map[string]models.CheckArchWarningCycleStep {
+	// sort a copy, project files is owned by caller
+	projectFiles = append(make([]models.FileHold, 0, len(projectFiles)), projectFiles...)
+	sort.Slice(projectFiles, func(i, j int) bool {
+		return projectFiles[i].File.Path < projectFiles[j].File.Path
+	})
+
+	packageComponents := make(map[string]string)
+	for _, projectFile := range projectFiles {
+		if projectFile.ComponentID == nil {
+			continue
+		}
+
+		packageComponents[c.packageImportPath(spec, projectFile.File.Path)] = *projectFile.ComponentID
+	}
+
+	edges := make(map[string]map[string]models.CheckArchWarningCycleStep)
+	for _, projectFile := range projectFiles {
+		if projectFile.ComponentID == nil {
+			continue
+		}
+
+		if projectFile.File.IsTest() {
+			// test packages are not imported by other code,
+			// so they can't be part of any cycle
+			continue
+		}
+
+		from := *projectFile.ComponentID
+
+		for _, resolvedImport := range projectFile.File.Imports {
+			if resolvedImport.ImportType != models.ImportTypeProject {
+				continue
+			}
+
+			to, ok := packageComponents[resolvedImport.Name]
+			if !ok || to == from {
+				continue
+			}
+
+			if _, ok := edges[from]; !ok {
+				edges[from] = make(map[string]models.CheckArchWarningCycleStep)
+			}
+
+			if _, exist := edges[from][to]; exist {
+				continue
+			}
+
+			edges[from][to] = models.CheckArchWarningCycleStep{
+				ComponentName:      from,
+				DependOn:           to,
+				FileRelativePath:   strings.TrimPrefix(projectFile.File.Path, spec.RootDirectory.Value),
+				FileAbsolutePath:   projectFile.File.Path,
+				ResolvedImportName: resolvedImport.Name,
+				Reference:          resolvedImport.Reference,
+			}
+		}
+	}
+
+	return edges
+}
+
+func (c *Cycles) packageImportPath(spec arch.Spec, filePath string) string {
+	if importPath, found := spec.Modules.ImportPath(filepath.Dir(filePath)); found {
+		return importPath
+	}
+
+	relativeDirectory := strings.TrimPrefix(filepath.Dir(filePath), spec.RootDirectory.Value)
+	return spec.ModuleName.Value + filepath.ToSlash(relativeDirectory)
+}
@@ -6,8 +6,10 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
@@ -25,6 +27,7 @@ type DeepScan struct {
 	result            models.CheckResult
 	fileComponents    map[string]string
 	packageComponents map[string]string
+	files             map[string]models.ProjectFile
 
 	sync.Mutex
 }
@@ -86,6 +89,7 @@ func (c *DeepScan) Check(ctx context.Context, spec arch.Spec) (models.CheckResul
 
 	c.fileComponents = map[string]string{}
 	c.packageComponents = map[string]string{}
+	c.files = map[string]models.ProjectFile{}
 
 	for _, hold := range mapping {
 		if hold.ComponentID == nil {
@@ -94,6 +98,7 @@ func (c *DeepScan) Check(ctx context.Context, spec arch.Spec) (models.CheckResul
 
 		// cache file -> component ref
 		c.fileComponents[hold.File.Path] = *hold.ComponentID
+		c.files[hold.File.Path] = hold.File
 
 		// cache package -> component ref
 		packagePath := filepath.Dir(hold.File.Path)
@@ -113,7 +118,7 @@ func (c *DeepScan) Check(ctx context.Context, spec arch.Spec) (models.CheckResul
 				<-pool
 			}()
 
-			if component.DeepScan.Value != true {
+			if component.DeepScan.Value != true && len(component.SymbolRules) == 0 {
 				return nil
 			}
 
@@ -134,6 +139,10 @@ func (c *DeepScan) Check(ctx context.Context, spec arch.Spec) (models.CheckResul
 		return models.CheckResult{}, err
 	}
 
+	sort.SliceStable(c.result.SymbolWarnings, func(i, j int) bool {
+		return lessReference(c.result.SymbolWarnings[i].Reference, c.result.SymbolWarnings[j].Reference)
+	})
+
 	return c.result, nil
 }
 
@@ -155,9 +164,18 @@ func (c *DeepScan) checkComponent(ctx context.Context, cmp arch.Component) error
 			continue
 		}
 
-		err := c.scanPackage(ctx, &cmp, absPath)
-		if err != nil {
-			return fmt.Errorf("failed scan '%s': %w", absPath, err)
+		if cmp.DeepScan.Value {
+			err := c.scanPackage(ctx, &cmp, absPath)
+			if err != nil {
+				return fmt.Errorf("failed scan '%s': %w", absPath, err)
+			}
+		}
+
+		if len(cmp.SymbolRules) > 0 {
+			err := c.checkSymbols(cmp, absPath)
+			if err != nil {
+				return fmt.Errorf("failed check symbols '%s': %w", absPath, err)
+			}
 		}
 	}
 
@@ -227,12 +245,24 @@ func (c *DeepScan) checkImplementation(
 ) error {
 	injectedImport := imp.Target.Definition.Import
 
-	for _, allowedImport := range cmp.AllowedProjectImports {
-		if allowedImport.Value.ImportPath == injectedImport {
-			return nil
+	if !isProjectImportDenied(*cmp, injectedImport) {
+		for _, allowedImport := range cmp.AllowedProjectImports {
+			if allowedImport.Value.ImportPath == injectedImport {
+				return nil
+			}
 		}
 	}
 
+	// temporary allowed by exception in arch file
+	now := time.Now()
+	exception, found := findException(*cmp, models.ResolvedImport{
+		Name:       injectedImport,
+		ImportType: models.ImportTypeProject,
+	}, now)
+	if found && !exception.IsExpired(now) {
+		return nil
+	}
+
 	targetPath := imp.Target.Definition.Place.File
 	targetComponentID, targetDefined := c.fileComponents[targetPath]
 
@@ -249,7 +279,24 @@ func (c *DeepScan) checkImplementation(
 		return nil
 	}
 
+	if suppression, suppressed := c.injectionSuppression(imp.Injector); suppressed {
+		c.result.SuppressedWarnings = append(c.result.SuppressedWarnings, models.CheckSuppressedWarning{
+			Kind:          models.BaselineKindDeepScan,
+			ComponentName: gateComponentID,
+			Target: fmt.Sprintf("%s.%s",
+				imp.Target.Definition.Pkg,
+				imp.Target.StructName,
+			),
+			Reason:      suppression.Reason,
+			Reference:   imp.Injector.ParamDefinition.Place,
+			Suppression: suppression.Reference,
+		})
+
+		return nil
+	}
+
 	warn := models.CheckArchWarningDeepscan{
+		Severity: cmp.Severity.DeepScan.Value,
 		Gate: models.DeepscanWarningGate{
 			ComponentName: gateComponentID,
 			MethodName:    gate.MethodName,
@@ -281,6 +328,23 @@ func (c *DeepScan) checkImplementation(
 	return nil
 }
 
+// injectionSuppression find suppression comment on constructor
+// call line, or on line of injected argument
+func (c *DeepScan) injectionSuppression(injector deepscan.Injector) (models.Suppression, bool) {
+	for _, place := range []common.Reference{injector.MethodDefinition.Place, injector.ParamDefinition.Place} {
+		file, ok := c.files[place.File]
+		if !ok {
+			continue
+		}
+
+		if suppression, suppressed := file.SuppressionAt(place.Line); suppressed {
+			return suppression, true
+		}
+	}
+
+	return models.Suppression{}, false
+}
+
 func (c *DeepScan) renderCode(pointer, from, to common.Reference) []byte {
 	return c.sourceCodeRenderer.SourceCode(
 		common.NewReferenceRange(pointer.File, from.Line, pointer.Line, to.Line),
@@ -4,17 +4,29 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
 	"github.com/fe3dback/go-arch-lint/internal/models/common"
 )
 
-type Imports struct {
-	spec                 arch.Spec
-	projectFilesResolver projectFilesResolver
-	result               results
-}
+type (
+	Imports struct {
+		spec                 arch.Spec
+		projectFilesResolver projectFilesResolver
+		result               results
+		reportedModules      map[reportedModule]struct{}
+		now                  time.Time
+	}
+
+	// undeclared module is reported once for every component, that import it.
+	// Components can have different severity, so every component has own warning
+	reportedModule struct {
+		component string
+		module    string
+	}
+)
 
 func NewImport(
 	projectFilesResolver projectFilesResolver,
@@ -22,11 +34,15 @@ func NewImport(
 	return &Imports{
 		result:               newResults(),
 		projectFilesResolver: projectFilesResolver,
+		reportedModules:      make(map[reportedModule]struct{}),
 	}
 }
 
 func (c *Imports) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
 	c.spec = spec
+	c.result = newResults()
+	c.reportedModules = make(map[reportedModule]struct{})
+	c.now = time.Now()
 
 	projectFiles, err := c.projectFilesResolver.ProjectFiles(ctx, spec)
 	if err != nil {
@@ -36,8 +52,11 @@ func (c *Imports) Check(ctx context.Context, spec arch.Spec) (models.CheckResult
 	components := c.assembleComponentsMap(spec)
 
 	for _, projectFile := range projectFiles {
+		c.collectSuppressions(projectFile)
+
 		if projectFile.ComponentID == nil {
 			c.result.addNotMatchedWarning(models.CheckArchWarningMatch{
+				Severity:         spec.Severity.NotMatched.Value,
 				Reference:        common.NewEmptyReference(),
 				FileRelativePath: strings.TrimPrefix(projectFile.File.Path, spec.RootDirectory.Value),
 				FileAbsolutePath: projectFile.File.Path,
@@ -48,7 +67,7 @@ func (c *Imports) Check(ctx context.Context, spec arch.Spec) (models.CheckResult
 
 		componentID := *projectFile.ComponentID
 		if component, ok := components[componentID]; ok {
-			err := c.checkFile(component, projectFile.File)
+			err := c.checkFile(component, projectFile.File, projectFile.Captures)
 			if err != nil {
 				return models.CheckResult{}, fmt.Errorf("failed check file '%s': %w", projectFile.File.Path, err)
 			}
@@ -62,6 +81,28 @@ func (c *Imports) Check(ctx context.Context, spec arch.Spec) (models.CheckResult
 	return c.result.assembleSortedResults(), nil
 }
 
+// CheckFile check imports of single file, that already attached to
+// component, without project scan (used by go/analysis analyzer).
+// Reported undeclared modules are remembered between calls, so same
+// module is reported once per component in all checked files, like in Check.
+func (c *Imports) CheckFile(
+	spec arch.Spec,
+	component arch.Component,
+	file models.ProjectFile,
+	captures map[string]string,
+) (models.CheckResult, error) {
+	c.spec = spec
+	c.result = newResults()
+	c.now = time.Now()
+
+	err := c.checkFile(component, file, captures)
+	if err != nil {
+		return models.CheckResult{}, fmt.Errorf("failed check file '%s': %w", file.Path, err)
+	}
+
+	return c.result.assembleSortedResults(), nil
+}
+
 func (c *Imports) assembleComponentsMap(spec arch.Spec) map[string]arch.Component {
 	results := make(map[string]arch.Component)
 
@@ -72,9 +113,23 @@ func (c *Imports) assembleComponentsMap(spec arch.Spec) map[string]arch.Componen
 	return results
 }
 
-func (c *Imports) checkFile(component arch.Component, file models.ProjectFile) error {
+func (c *Imports) checkFile(component arch.Component, file models.ProjectFile, captures map[string]string) error {
+	component = component.ForFile(file)
+
 	for _, resolvedImport := range file.Imports {
-		allowed, err := checkImport(component, resolvedImport, c.spec.Allow.DepOnAnyVendor.Value)
+		if resolvedImport.ImportType == models.ImportTypeStdLib {
+			err := c.checkStdImport(component, file, resolvedImport)
+			if err != nil {
+				return fmt.Errorf("failed check std import '%s': %w",
+					resolvedImport.Name,
+					err,
+				)
+			}
+
+			continue
+		}
+
+		allowed, err := checkImport(component, resolvedImport, c.spec.Allow.DepOnAnyVendor.Value, captures)
 		if err != nil {
 			return fmt.Errorf("failed check import '%s': %w",
 				resolvedImport.Name,
@@ -86,50 +141,212 @@ func (c *Imports) checkFile(component arch.Component, file models.ProjectFile) e
 			continue
 		}
 
+		if exception, found := findException(component, resolvedImport, c.now); found {
+			if exception.IsExpired(c.now) {
+				c.addExpiredWarning(component, file, resolvedImport, exception)
+			}
+
+			continue
+		}
+
+		if c.isSuppressed(models.BaselineKindDependency, component, file, resolvedImport) {
+			continue
+		}
+
+		module, err := c.undeclaredModule(resolvedImport)
+		if err != nil {
+			return fmt.Errorf("failed check import '%s' module: %w",
+				resolvedImport.Name,
+				err,
+			)
+		}
+
+		if module != "" {
+			reported := reportedModule{component: component.Name.Value, module: module}
+			if _, ok := c.reportedModules[reported]; ok {
+				continue
+			}
+
+			c.reportedModules[reported] = struct{}{}
+		}
+
 		c.result.addDependencyWarning(models.CheckArchWarningDependency{
+			Severity:           importSeverity(component, resolvedImport),
 			Reference:          resolvedImport.Reference,
 			ComponentName:      component.Name.Value,
 			FileRelativePath:   strings.TrimPrefix(file.Path, c.spec.RootDirectory.Value),
 			FileAbsolutePath:   file.Path,
 			ResolvedImportName: resolvedImport.Name,
+			Module:             module,
+			NotExportedBy:      notExportedBy(component, resolvedImport),
 		})
 	}
 
 	return nil
 }
 
+func (c *Imports) checkStdImport(component arch.Component, file models.ProjectFile, resolvedImport models.ResolvedImport) error {
+	allowed, err := checkStdImport(component, c.spec.DeniedStdGlobs, resolvedImport)
+	if err != nil {
+		return err
+	}
+
+	if allowed || c.isSuppressed(models.BaselineKindStd, component, file, resolvedImport) {
+		return nil
+	}
+
+	c.result.addStdWarning(models.CheckArchWarningStd{
+		Severity:           component.Severity.Std.Value,
+		ComponentName:      component.Name.Value,
+		FileRelativePath:   strings.TrimPrefix(file.Path, c.spec.RootDirectory.Value),
+		FileAbsolutePath:   file.Path,
+		ResolvedImportName: resolvedImport.Name,
+		Reference:          resolvedImport.Reference,
+	})
+
+	return nil
+}
+
+// isSuppressed is true, when import line has suppression comment,
+// suppressed warning is still collected for the report
+func (c *Imports) isSuppressed(
+	kind models.BaselineKind,
+	component arch.Component,
+	file models.ProjectFile,
+	resolvedImport models.ResolvedImport,
+) bool {
+	suppression, suppressed := file.SuppressionAt(resolvedImport.Reference.Line)
+	if !suppressed {
+		return false
+	}
+
+	c.result.addSuppressedWarning(models.CheckSuppressedWarning{
+		Kind:          kind,
+		ComponentName: component.Name.Value,
+		Target:        resolvedImport.Name,
+		Reason:        suppression.Reason,
+		Reference:     resolvedImport.Reference,
+		Suppression:   suppression.Reference,
+	})
+
+	return true
+}
+
+func (c *Imports) addExpiredWarning(
+	component arch.Component,
+	file models.ProjectFile,
+	resolvedImport models.ResolvedImport,
+	exception arch.Exception,
+) {
+	c.result.addExpiredWarning(models.CheckArchWarningExpired{
+		Severity:           component.Severity.ExpiredExceptions.Value,
+		ComponentName:      component.Name.Value,
+		FileRelativePath:   strings.TrimPrefix(file.Path, c.spec.RootDirectory.Value),
+		FileAbsolutePath:   file.Path,
+		ResolvedImportName: resolvedImport.Name,
+		Reference:          resolvedImport.Reference,
+		Target:             exception.Target.Value,
+		Expires:            exception.Expires.Value.Format(models.ExceptionDateLayout),
+		Reason:             exception.Reason.Value,
+		Owner:              exception.Owner.Value,
+		Ticket:             exception.Ticket.Value,
+		ExceptionReference: exception.Target.Reference,
+	})
+}
+
+// undeclaredModule returns required module of vendor import, when
+// this import not matched by any vendor from spec
+func (c *Imports) undeclaredModule(resolvedImport models.ResolvedImport) (string, error) {
+	if resolvedImport.ImportType != models.ImportTypeVendor || resolvedImport.Module == "" {
+		return "", nil
+	}
+
+	for _, vendor := range c.spec.Vendors {
+		for _, vendorGlob := range vendor.ImportGlobs {
+			matched, err := vendorGlob.Value.Match(resolvedImport)
+			if err != nil {
+				return "", fmt.Errorf("invalid vendor glob '%s': %w", vendorGlob.Value, err)
+			}
+
+			if matched {
+				return "", nil
+			}
+		}
+	}
+
+	return resolvedImport.Module, nil
+}
+
+// importSeverity is level of denied import, vendor and project
+// imports can have different severity
+func importSeverity(component arch.Component, resolvedImport models.ResolvedImport) models.Severity {
+	if resolvedImport.ImportType == models.ImportTypeVendor {
+		return component.Severity.Vendors.Value
+	}
+
+	return component.Severity.Imports.Value
+}
+
+func (c *Imports) collectSuppressions(projectFile models.FileHold) {
+	componentName := ""
+	if projectFile.ComponentID != nil {
+		componentName = *projectFile.ComponentID
+	}
+
+	for _, suppression := range projectFile.File.Suppressions {
+		c.result.addSuppression(models.CheckSuppression{
+			ComponentName:    componentName,
+			FileRelativePath: strings.TrimPrefix(projectFile.File.Path, c.spec.RootDirectory.Value),
+			FileAbsolutePath: projectFile.File.Path,
+			Reason:           suppression.Reason,
+			Reference:        suppression.Reference,
+		})
+	}
+}
+
 func checkImport(
 	component arch.Component,
 	resolvedImport models.ResolvedImport,
 	allowDependOnAnyVendor bool,
+	captures map[string]string,
 ) (bool, error) {
 	switch resolvedImport.ImportType {
 	case models.ImportTypeStdLib:
 		return true, nil
 	case models.ImportTypeVendor:
 		if allowDependOnAnyVendor {
-			return true, nil
+			denied, err := isVendorImportDenied(component, resolvedImport)
+			return !denied, err
 		}
 
 		return checkVendorImport(component, resolvedImport)
 	case models.ImportTypeProject:
-		return checkProjectImport(component, resolvedImport), nil
+		return checkProjectImport(component, resolvedImport, captures), nil
 	default:
 		panic(fmt.Sprintf("unknown import type: %+v", resolvedImport))
 	}
 }
 
 func checkVendorImport(component arch.Component, resolvedImport models.ResolvedImport) (bool, error) {
+	denied, err := isVendorImportDenied(component, resolvedImport)
+	if err != nil {
+		return false, err
+	}
+
+	if denied {
+		return false, nil
+	}
+
 	if component.SpecialFlags.AllowAllVendorDeps.Value {
 		return true, nil
 	}
 
 	for _, vendorGlob := range component.AllowedVendorGlobs {
-		matched, err := vendorGlob.Value.Match(resolvedImport.Name)
+		matched, err := vendorGlob.Value.Match(resolvedImport)
 		if err != nil {
 			return false, models.NewReferableErr(
 				fmt.Errorf("invalid vendor glob '%s': %w",
-					string(vendorGlob.Value),
+					vendorGlob.Value,
 					err,
 				),
 				vendorGlob.Reference,
@@ -144,7 +361,59 @@ func checkVendorImport(component arch.Component, resolvedImport models.ResolvedI
 	return false, nil
 }
 
-func checkProjectImport(component arch.Component, resolvedImport models.ResolvedImport) bool {
+// std imports is allowed by default, component deny list take precedence over
+// component allow list, and component allow list take precedence over global deny list.
+// When component allow list is not empty, all other std packages is not allowed
+func checkStdImport(
+	component arch.Component,
+	globalDenied []common.Referable[models.Glob],
+	resolvedImport models.ResolvedImport,
+) (bool, error) {
+	denied, err := matchAnyGlob(component.DeniedStdGlobs, resolvedImport.Name)
+	if err != nil || denied {
+		return false, err
+	}
+
+	allowed, err := matchAnyGlob(component.AllowedStdGlobs, resolvedImport.Name)
+	if err != nil || allowed {
+		return allowed, err
+	}
+
+	denied, err = matchAnyGlob(globalDenied, resolvedImport.Name)
+	if err != nil || denied {
+		return false, err
+	}
+
+	return len(component.AllowedStdGlobs) == 0, nil
+}
+
+func matchAnyGlob(globs []common.Referable[models.Glob], importPath string) (bool, error) {
+	for _, glob := range globs {
+		matched, err := glob.Value.Match(importPath)
+		if err != nil {
+			return false, models.NewReferableErr(
+				fmt.Errorf("invalid glob '%s': %w",
+					string(glob.Value),
+					err,
+				),
+				glob.Reference,
+			)
+		}
+
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// captures is named wildcards values of importing file package
+func checkProjectImport(component arch.Component, resolvedImport models.ResolvedImport, captures map[string]string) bool {
+	if isProjectImportDenied(component, resolvedImport.Name) {
+		return false
+	}
+
 	if component.SpecialFlags.AllowAllProjectDeps.Value {
 		return true
 	}
@@ -152,10 +421,141 @@ func checkProjectImport(component arch.Component, resolvedImport models.Resolved
 	for _, allowedImportRef := range component.AllowedProjectImports {
 		allowedImport := allowedImportRef.Value
 
-		if allowedImport.ImportPath == resolvedImport.Name {
+		if allowedImport.ImportPath != resolvedImport.Name {
+			continue
+		}
+
+		if isCaptureAllowed(component, allowedImport, captures) {
 			return true
 		}
 	}
 
 	return false
 }
+
+// with 'sameCapture' rule, component can import instances with another
+// named wildcards values, only from components in 'anyCapture' list
+func isCaptureAllowed(component arch.Component, allowedImport models.ResolvedPath, captures map[string]string) bool {
+	if !component.SpecialFlags.SameCapture.Value {
+		return true
+	}
+
+	if models.SameCaptures(captures, allowedImport.Captures) {
+		return true
+	}
+
+	for _, anyCaptureRef := range component.AnyCaptureImports {
+		if anyCaptureRef.Value.ImportPath == allowedImport.ImportPath {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findException returns exception, that allow this import,
+// active exceptions has priority over already expired
+func findException(component arch.Component, resolvedImport models.ResolvedImport, now time.Time) (arch.Exception, bool) {
+	var found *arch.Exception
+
+	for ind := range component.Exceptions {
+		exception := component.Exceptions[ind]
+		if !isExceptionMatched(exception, resolvedImport) {
+			continue
+		}
+
+		if !exception.IsExpired(now) {
+			return exception, true
+		}
+
+		if found == nil {
+			found = &exception
+		}
+	}
+
+	if found == nil {
+		return arch.Exception{}, false
+	}
+
+	return *found, true
+}
+
+func isExceptionMatched(exception arch.Exception, resolvedImport models.ResolvedImport) bool {
+	switch resolvedImport.ImportType {
+	case models.ImportTypeProject:
+		for _, projectImport := range exception.ProjectImports {
+			if projectImport.Value.ImportPath == resolvedImport.Name {
+				return true
+			}
+		}
+	case models.ImportTypeVendor:
+		for _, vendorGlob := range exception.VendorGlobs {
+			if matched, err := vendorGlob.Value.Match(resolvedImport); err == nil && matched {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// deny rules always take precedence over any allow rules
+func isVendorImportDenied(component arch.Component, resolvedImport models.ResolvedImport) (bool, error) {
+	for _, vendorGlob := range component.DeniedVendorGlobs {
+		matched, err := vendorGlob.Value.Match(resolvedImport)
+		if err != nil {
+			return false, models.NewReferableErr(
+				fmt.Errorf("invalid vendor glob '%s': %w",
+					vendorGlob.Value,
+					err,
+				),
+				vendorGlob.Reference,
+			)
+		}
+
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func isProjectImportDenied(component arch.Component, importPath string) bool {
+	for _, deniedImportRef := range component.DeniedProjectImports {
+		if deniedImportRef.Value.ImportPath == importPath {
+			return true
+		}
+	}
+
+	// not exported packages of other components is like go "internal" packages
+	for _, hiddenImport := range component.NotExportedImports {
+		if hiddenImport.ResolvedPath.Value.ImportPath == importPath {
+			return true
+		}
+	}
+
+	return false
+}
+
+// notExportedBy returns owner component of imported package, when
+// import is denied only because package is not exported by owner
+func notExportedBy(component arch.Component, resolvedImport models.ResolvedImport) string {
+	if resolvedImport.ImportType != models.ImportTypeProject {
+		return ""
+	}
+
+	for _, deniedImportRef := range component.DeniedProjectImports {
+		if deniedImportRef.Value.ImportPath == resolvedImport.Name {
+			return ""
+		}
+	}
+
+	for _, hiddenImport := range component.NotExportedImports {
+		if hiddenImport.ResolvedPath.Value.ImportPath == resolvedImport.Name {
+			return hiddenImport.ComponentName
+		}
+	}
+
+	return ""
+}
@@ -1,9 +1,11 @@
 package checker
 
 import (
+	"context"
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
 	"github.com/fe3dback/go-arch-lint/internal/models/common"
@@ -121,7 +123,7 @@ func Test_checkImportPath(t *testing.T) {
 				AllowedProjectImports: tt.args.componentImports,
 			}
 
-			if got := checkProjectImport(cmp, tt.args.resolvedImport); got != tt.want {
+			if got := checkProjectImport(cmp, tt.args.resolvedImport, nil); got != tt.want {
 				t.Errorf("checkImportPath() = %v, want %v", got, tt.want)
 			}
 		})
@@ -260,13 +262,49 @@ func Test_checkProjectImport(t *testing.T) {
 				AllowedProjectImports: tt.args.componentImports,
 			}
 
-			if got := checkProjectImport(cmp, tt.args.resolvedImport); got != tt.want {
+			if got := checkProjectImport(cmp, tt.args.resolvedImport, nil); got != tt.want {
 				t.Errorf("checkProjectImport() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
+func Test_checkProjectImportCaptures(t *testing.T) {
+	makeCapturedPath := func(localPath string, ctx string) common.Referable[models.ResolvedPath] {
+		path := makeTestResolvedPath(localPath)
+		path.Value.Captures = map[string]string{"ctx": ctx}
+
+		return path
+	}
+
+	cmp := arch.Component{
+		Name: common.NewReferable("service", common.NewEmptyReference()),
+		SpecialFlags: arch.SpecialFlags{
+			AllowAllProjectDeps: makeBool(false),
+			AllowAllVendorDeps:  makeBool(false),
+			SameCapture:         makeBool(true),
+		},
+		AllowedProjectImports: []common.Referable[models.ResolvedPath]{
+			makeCapturedPath("billing/repository", "billing"),
+			makeCapturedPath("users/repository", "users"),
+			makeCapturedPath("users/events", "users"),
+		},
+		AnyCaptureImports: []common.Referable[models.ResolvedPath]{
+			makeCapturedPath("users/events", "users"),
+		},
+	}
+
+	billing := map[string]string{"ctx": "billing"}
+
+	assert.True(t, checkProjectImport(cmp, makeTestResolvedProjectImport("billing/repository"), billing))
+	assert.False(t, checkProjectImport(cmp, makeTestResolvedProjectImport("users/repository"), billing))
+	assert.True(t, checkProjectImport(cmp, makeTestResolvedProjectImport("users/events"), billing))
+	assert.True(t, checkProjectImport(cmp, makeTestResolvedProjectImport("users/repository"), nil))
+
+	cmp.SpecialFlags.SameCapture = makeBool(false)
+	assert.True(t, checkProjectImport(cmp, makeTestResolvedProjectImport("users/repository"), billing))
+}
+
 func Test_checkVendorImport(t *testing.T) {
 	type args struct {
 		componentImports []common.Referable[models.ResolvedPath]
@@ -401,7 +439,7 @@ func TestChecker_checkImport(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := checkImport(cmp, tt.args.resolvedImport, tt.args.dependOnAnyVendor)
+			got, err := checkImport(cmp, tt.args.resolvedImport, tt.args.dependOnAnyVendor, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
@@ -419,6 +457,558 @@ func TestChecker_checkImport(t *testing.T) {
 			ImportType: 100,
 		}
 
-		_, _ = checkImport(cmp, resolvedImport, false)
+		_, _ = checkImport(cmp, resolvedImport, false, nil)
 	})
 }
+
+func TestChecker_checkImportDenied(t *testing.T) {
+	type args struct {
+		resolvedImport    models.ResolvedImport
+		componentFlags    arch.SpecialFlags
+		dependOnAnyVendor bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "project denied, but allowed by list",
+			args: args{
+				resolvedImport: makeTestResolvedProjectImport("needle"),
+				componentFlags: arch.SpecialFlags{
+					AllowAllProjectDeps: makeBool(false),
+					AllowAllVendorDeps:  makeBool(false),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "project denied, but allowed by flag",
+			args: args{
+				resolvedImport: makeTestResolvedProjectImport("needle"),
+				componentFlags: arch.SpecialFlags{
+					AllowAllProjectDeps: makeBool(true),
+					AllowAllVendorDeps:  makeBool(false),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "project not denied, allowed by flag",
+			args: args{
+				resolvedImport: makeTestResolvedProjectImport("some"),
+				componentFlags: arch.SpecialFlags{
+					AllowAllProjectDeps: makeBool(true),
+					AllowAllVendorDeps:  makeBool(false),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "vendor denied, but allowed by list",
+			args: args{
+				resolvedImport: makeTestResolvedVendorImport("needle"),
+				componentFlags: arch.SpecialFlags{
+					AllowAllProjectDeps: makeBool(false),
+					AllowAllVendorDeps:  makeBool(false),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "vendor denied, but allowed by flag",
+			args: args{
+				resolvedImport: makeTestResolvedVendorImport("needle"),
+				componentFlags: arch.SpecialFlags{
+					AllowAllProjectDeps: makeBool(false),
+					AllowAllVendorDeps:  makeBool(true),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "vendor denied, but allowed globally",
+			args: args{
+				resolvedImport: makeTestResolvedVendorImport("needle"),
+				componentFlags: arch.SpecialFlags{
+					AllowAllProjectDeps: makeBool(false),
+					AllowAllVendorDeps:  makeBool(false),
+				},
+				dependOnAnyVendor: true,
+			},
+			want: false,
+		},
+		{
+			name: "vendor not denied, allowed globally",
+			args: args{
+				resolvedImport: makeTestResolvedVendorImport("some"),
+				componentFlags: arch.SpecialFlags{
+					AllowAllProjectDeps: makeBool(false),
+					AllowAllVendorDeps:  makeBool(false),
+				},
+				dependOnAnyVendor: true,
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmp := arch.Component{
+				Name:         common.NewReferable("component", common.NewEmptyReference()),
+				SpecialFlags: tt.args.componentFlags,
+				AllowedProjectImports: []common.Referable[models.ResolvedPath]{
+					makeTestResolvedPath("needle"),
+				},
+				AllowedVendorGlobs: []common.Referable[models.VendorGlob]{
+					common.NewReferable(models.VendorGlob{Glob: models.Glob("github.com/vendor/lib/needle")}, common.NewEmptyReference()),
+				},
+				DeniedProjectImports: []common.Referable[models.ResolvedPath]{
+					makeTestResolvedPath("needle"),
+				},
+				DeniedVendorGlobs: []common.Referable[models.VendorGlob]{
+					common.NewReferable(models.VendorGlob{Glob: models.Glob("github.com/vendor/lib/needle")}, common.NewEmptyReference()),
+				},
+			}
+
+			got, err := checkImport(cmp, tt.args.resolvedImport, tt.args.dependOnAnyVendor, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChecker_checkImportNotExported(t *testing.T) {
+	tests := []struct {
+		name           string
+		resolvedImport models.ResolvedImport
+		allowAll       bool
+		want           bool
+	}{
+		{
+			name:           "exported package",
+			resolvedImport: makeTestResolvedProjectImport("billing/api"),
+			want:           true,
+		},
+		{
+			name:           "not exported package",
+			resolvedImport: makeTestResolvedProjectImport("billing/impl"),
+			want:           false,
+		},
+		{
+			name:           "not exported package with any project deps",
+			resolvedImport: makeTestResolvedProjectImport("billing/impl"),
+			allowAll:       true,
+			want:           false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmp := arch.Component{
+				Name: common.NewReferable("component", common.NewEmptyReference()),
+				SpecialFlags: arch.SpecialFlags{
+					AllowAllProjectDeps: makeBool(tt.allowAll),
+					AllowAllVendorDeps:  makeBool(false),
+				},
+				AllowedProjectImports: []common.Referable[models.ResolvedPath]{
+					makeTestResolvedPath("billing/api"),
+					makeTestResolvedPath("billing/impl"),
+				},
+				NotExportedImports: []arch.NotExportedImport{
+					{ComponentName: "billing", ResolvedPath: makeTestResolvedPath("billing/impl")},
+				},
+			}
+
+			got, err := checkImport(cmp, tt.resolvedImport, false, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			if !tt.want {
+				assert.Equal(t, "billing", notExportedBy(cmp, tt.resolvedImport))
+			}
+		})
+	}
+}
+
+func Test_findException(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.Local)
+	makeException := func(target string, expires time.Time) arch.Exception {
+		return arch.Exception{
+			Target:  common.NewReferable(target, common.NewEmptyReference()),
+			Expires: common.NewReferable(expires, common.NewEmptyReference()),
+			ProjectImports: []common.Referable[models.ResolvedPath]{
+				makeTestResolvedPath(target),
+			},
+			VendorGlobs: []common.Referable[models.VendorGlob]{
+				common.NewReferable(models.VendorGlob{Glob: models.Glob("github.com/vendor/lib/" + target)}, common.NewEmptyReference()),
+			},
+		}
+	}
+
+	yesterday := time.Date(2024, 6, 14, 0, 0, 0, 0, time.Local)
+	today := time.Date(2024, 6, 15, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name           string
+		exceptions     []arch.Exception
+		resolvedImport models.ResolvedImport
+		wantFound      bool
+		wantExpired    bool
+	}{
+		{
+			name:           "no exceptions",
+			resolvedImport: makeTestResolvedProjectImport("needle"),
+			wantFound:      false,
+		},
+		{
+			name:           "other target",
+			exceptions:     []arch.Exception{makeException("other", today)},
+			resolvedImport: makeTestResolvedProjectImport("needle"),
+			wantFound:      false,
+		},
+		{
+			name:           "active until end of day",
+			exceptions:     []arch.Exception{makeException("needle", today)},
+			resolvedImport: makeTestResolvedProjectImport("needle"),
+			wantFound:      true,
+			wantExpired:    false,
+		},
+		{
+			name:           "expired",
+			exceptions:     []arch.Exception{makeException("needle", yesterday)},
+			resolvedImport: makeTestResolvedProjectImport("needle"),
+			wantFound:      true,
+			wantExpired:    true,
+		},
+		{
+			name:           "active has priority over expired",
+			exceptions:     []arch.Exception{makeException("needle", yesterday), makeException("needle", today)},
+			resolvedImport: makeTestResolvedProjectImport("needle"),
+			wantFound:      true,
+			wantExpired:    false,
+		},
+		{
+			name:           "vendor",
+			exceptions:     []arch.Exception{makeException("needle", today)},
+			resolvedImport: makeTestResolvedVendorImport("needle"),
+			wantFound:      true,
+			wantExpired:    false,
+		},
+		{
+			name:           "stdlib",
+			exceptions:     []arch.Exception{makeException("needle", today)},
+			resolvedImport: makeTestResolvedStdlibImport(),
+			wantFound:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmp := arch.Component{
+				Name:       common.NewReferable("component", common.NewEmptyReference()),
+				Exceptions: tt.exceptions,
+			}
+
+			exception, found := findException(cmp, tt.resolvedImport, now)
+			assert.Equal(t, tt.wantFound, found)
+			if found {
+				assert.Equal(t, tt.wantExpired, exception.IsExpired(now))
+			}
+		})
+	}
+}
+
+func TestChecker_checkImportTests(t *testing.T) {
+	cmp := arch.Component{
+		Name:          common.NewReferable("service", common.NewEmptyReference()),
+		ResolvedPaths: []common.Referable[models.ResolvedPath]{makeTestResolvedPath("service")},
+		AllowedProjectImports: []common.Referable[models.ResolvedPath]{
+			makeTestResolvedPath("repository"),
+		},
+		Tests: arch.AdditionalRules{
+			AllowedProjectImports: []common.Referable[models.ResolvedPath]{
+				makeTestResolvedPath("fakes"),
+			},
+			AllowedVendorGlobs: []common.Referable[models.VendorGlob]{
+				common.NewReferable(models.VendorGlob{Glob: models.Glob("github.com/vendor/lib/testify/**")}, common.NewEmptyReference()),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		resolvedImport models.ResolvedImport
+		wantProduction bool
+		wantTests      bool
+	}{
+		{
+			name:           "production rules",
+			resolvedImport: makeTestResolvedProjectImport("repository"),
+			wantProduction: true,
+			wantTests:      true,
+		},
+		{
+			name:           "tests component",
+			resolvedImport: makeTestResolvedProjectImport("fakes"),
+			wantProduction: false,
+			wantTests:      true,
+		},
+		{
+			name:           "tests vendor",
+			resolvedImport: makeTestResolvedVendorImport("testify/assert"),
+			wantProduction: false,
+			wantTests:      true,
+		},
+		{
+			name:           "own package from external test",
+			resolvedImport: makeTestResolvedProjectImport("service"),
+			wantProduction: false,
+			wantTests:      true,
+		},
+		{
+			name:           "not allowed",
+			resolvedImport: makeTestResolvedProjectImport("handler"),
+			wantProduction: false,
+			wantTests:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checkImport(cmp, tt.resolvedImport, false, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantProduction, got)
+
+			got, err = checkImport(cmp.ForFile(models.ProjectFile{Kind: models.FileKindExternalTest}), tt.resolvedImport, false, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTests, got)
+		})
+	}
+}
+
+func Test_checkStdImport(t *testing.T) {
+	globs := func(list ...string) []common.Referable[models.Glob] {
+		res := make([]common.Referable[models.Glob], 0, len(list))
+		for _, glob := range list {
+			res = append(res, common.NewEmptyReferable(models.Glob(glob)))
+		}
+
+		return res
+	}
+
+	stdImport := func(name string) models.ResolvedImport {
+		return models.ResolvedImport{Name: name, ImportType: models.ImportTypeStdLib}
+	}
+
+	tests := []struct {
+		name         string
+		allowed      []common.Referable[models.Glob]
+		denied       []common.Referable[models.Glob]
+		globalDenied []common.Referable[models.Glob]
+		importName   string
+		want         bool
+	}{
+		{
+			name:       "no rules",
+			importName: "net/http",
+			want:       true,
+		},
+		{
+			name:       "denied by glob",
+			denied:     globs("net/**"),
+			importName: "net/http",
+			want:       false,
+		},
+		{
+			name:       "deny glob not match parent package",
+			denied:     globs("net/**"),
+			importName: "net",
+			want:       true,
+		},
+		{
+			name:       "deny take precedence over allow",
+			allowed:    globs("os/**"),
+			denied:     globs("os/exec"),
+			importName: "os/exec",
+			want:       false,
+		},
+		{
+			name:       "not in allow list",
+			allowed:    globs("fmt", "errors"),
+			importName: "strings",
+			want:       false,
+		},
+		{
+			name:       "in allow list",
+			allowed:    globs("fmt", "errors"),
+			importName: "errors",
+			want:       true,
+		},
+		{
+			name:         "global deny",
+			globalDenied: globs("unsafe", "reflect"),
+			importName:   "reflect",
+			want:         false,
+		},
+		{
+			name:         "component allow take precedence over global deny",
+			allowed:      globs("reflect"),
+			globalDenied: globs("unsafe", "reflect"),
+			importName:   "reflect",
+			want:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmp := arch.Component{
+				Name:            common.NewEmptyReferable("component"),
+				AllowedStdGlobs: tt.allowed,
+				DeniedStdGlobs:  tt.denied,
+			}
+
+			got, err := checkStdImport(cmp, tt.globalDenied, stdImport(tt.importName))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_checkStdImport_tests(t *testing.T) {
+	cmp := arch.Component{
+		Name:            common.NewEmptyReferable("component"),
+		AllowedStdGlobs: []common.Referable[models.Glob]{common.NewEmptyReferable(models.Glob("fmt"))},
+	}
+
+	testFile := models.ProjectFile{Kind: models.FileKindTest}
+
+	for _, importName := range []string{"testing", "testing/fstest"} {
+		got, err := checkStdImport(cmp, nil, models.ResolvedImport{Name: importName, ImportType: models.ImportTypeStdLib})
+		assert.NoError(t, err)
+		assert.False(t, got, importName)
+
+		got, err = checkStdImport(cmp.ForFile(testFile), nil, models.ResolvedImport{Name: importName, ImportType: models.ImportTypeStdLib})
+		assert.NoError(t, err)
+		assert.True(t, got, importName)
+	}
+
+	got, err := checkStdImport(cmp.ForFile(testFile), nil, models.ResolvedImport{Name: "os", ImportType: models.ImportTypeStdLib})
+	assert.NoError(t, err)
+	assert.False(t, got)
+}
+
+func Test_checkStdImport_testsWithoutAllowList(t *testing.T) {
+	cmp := arch.Component{
+		Name:           common.NewEmptyReferable("component"),
+		DeniedStdGlobs: []common.Referable[models.Glob]{common.NewEmptyReferable(models.Glob("os"))},
+	}
+
+	testCmp := cmp.ForFile(models.ProjectFile{Kind: models.FileKindTest})
+	assert.Empty(t, testCmp.AllowedStdGlobs)
+
+	for importName, want := range map[string]bool{
+		"testing":        true,
+		"testing/fstest": true,
+		"fmt":            true,
+		"os":             false,
+	} {
+		got, err := checkStdImport(testCmp, nil, models.ResolvedImport{Name: importName, ImportType: models.ImportTypeStdLib})
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, importName)
+	}
+}
+
+type testProjectFilesResolver []models.FileHold
+
+func (r testProjectFilesResolver) ProjectFiles(_ context.Context, _ arch.Spec) ([]models.FileHold, error) {
+	return r, nil
+}
+
+func TestImports_Check_undeclaredModuleReportedOnce(t *testing.T) {
+	componentID := "service"
+	otherComponentID := "api"
+	moduleImport := func(name string) models.ResolvedImport {
+		resolved := makeTestResolvedVendorImport(name)
+		resolved.Module = "github.com/vendor/lib"
+
+		return resolved
+	}
+
+	files := testProjectFilesResolver{
+		{
+			File: models.ProjectFile{
+				Path:    makeTestAbsPath("service/a.go"),
+				Imports: []models.ResolvedImport{moduleImport("a"), moduleImport("b")},
+			},
+			ComponentID: &componentID,
+		},
+		{
+			File: models.ProjectFile{
+				Path:    makeTestAbsPath("service/b.go"),
+				Imports: []models.ResolvedImport{moduleImport("a")},
+			},
+			ComponentID: &componentID,
+		},
+		{
+			File: models.ProjectFile{
+				Path:    makeTestAbsPath("api/a.go"),
+				Imports: []models.ResolvedImport{moduleImport("b")},
+			},
+			ComponentID: &otherComponentID,
+		},
+	}
+
+	spec := arch.Spec{
+		RootDirectory: common.NewEmptyReferable(makeTestProjectRoot()),
+		Components: []arch.Component{
+			{
+				Name: common.NewEmptyReferable(componentID),
+				Severity: arch.Severities{
+					Vendors: common.NewEmptyReferable(models.SeverityWarning),
+				},
+			},
+			{
+				Name: common.NewEmptyReferable(otherComponentID),
+				Severity: arch.Severities{
+					Vendors: common.NewEmptyReferable(models.SeverityError),
+				},
+			},
+		},
+	}
+
+	result, err := NewImport(files).Check(context.Background(), spec)
+	assert.NoError(t, err)
+	assert.Len(t, result.DependencyWarnings, 2)
+
+	reported := make(map[string]models.CheckArchWarningDependency)
+	for _, warn := range result.DependencyWarnings {
+		assert.Equal(t, "github.com/vendor/lib", warn.Module)
+		reported[warn.ComponentName] = warn
+	}
+
+	assert.Equal(t, "/service/a.go", reported[componentID].FileRelativePath)
+	assert.Equal(t, models.SeverityWarning, reported[componentID].Severity)
+	assert.Equal(t, "/api/a.go", reported[otherComponentID].FileRelativePath)
+	assert.Equal(t, models.SeverityError, reported[otherComponentID].Severity)
+}
+
+func TestImports_CheckFile_undeclaredModuleReportedOnce(t *testing.T) {
+	resolved := makeTestResolvedVendorImport("a")
+	resolved.Module = "github.com/vendor/lib"
+
+	spec := arch.Spec{RootDirectory: common.NewEmptyReferable(makeTestProjectRoot())}
+	component := arch.Component{Name: common.NewEmptyReferable("service")}
+	makeFile := func(localPath string) models.ProjectFile {
+		return models.ProjectFile{
+			Path:    makeTestAbsPath(localPath),
+			Imports: []models.ResolvedImport{resolved},
+		}
+	}
+
+	importsChecker := NewImport(nil)
+
+	result, err := importsChecker.CheckFile(spec, component, makeFile("service/a.go"), nil)
+	assert.NoError(t, err)
+	assert.Len(t, result.DependencyWarnings, 1)
+
+	result, err = importsChecker.CheckFile(spec, component, makeFile("service/b.go"), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, result.DependencyWarnings)
+}
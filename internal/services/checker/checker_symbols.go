@@ -0,0 +1,154 @@
+package checker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/checker/deepscan"
+)
+
+// CheckFileSymbols will check identifiers of imported packages, that used
+// in file code, with component symbol rules. Test files is not checked
+func (c *DeepScan) CheckFileSymbols(
+	spec arch.Spec,
+	component arch.Component,
+	file models.ProjectFile,
+	usages []deepscan.SymbolUsage,
+) (models.CheckResult, error) {
+	result := models.CheckResult{}
+
+	if file.IsTest() || len(component.SymbolRules) == 0 {
+		return result, nil
+	}
+
+	for _, usage := range usages {
+		rule, denied, err := findDeniedSymbolRule(spec.Modules, component, usage)
+		if err != nil {
+			return models.CheckResult{}, fmt.Errorf("failed check symbol '%s.%s': %w", usage.Pkg, usage.Name, err)
+		}
+
+		if !denied {
+			continue
+		}
+
+		symbol := fmt.Sprintf("%s.%s", usage.Pkg, usage.Name)
+
+		if suppression, suppressed := file.SuppressionAt(usage.Place.Line); suppressed {
+			result.SuppressedWarnings = append(result.SuppressedWarnings, models.CheckSuppressedWarning{
+				Kind:          models.BaselineKindSymbol,
+				ComponentName: component.Name.Value,
+				Target:        symbol,
+				Reason:        suppression.Reason,
+				Reference:     usage.Place,
+				Suppression:   suppression.Reference,
+			})
+
+			continue
+		}
+
+		result.SymbolWarnings = append(result.SymbolWarnings, models.CheckArchWarningSymbol{
+			Severity:           component.Severity.Symbols.Value,
+			ComponentName:      component.Name.Value,
+			FileRelativePath:   strings.TrimPrefix(file.Path, spec.RootDirectory.Value),
+			FileAbsolutePath:   file.Path,
+			ResolvedImportName: usage.Import,
+			Reference:          usage.Place,
+			Target:             rule.Target.Value,
+			Symbol:             symbol,
+		})
+	}
+
+	return result, nil
+}
+
+func (c *DeepScan) checkSymbols(cmp arch.Component, absPackagePath string) error {
+	usages, err := c.scanner.SymbolUsages(absPackagePath)
+	if err != nil {
+		return fmt.Errorf("find symbol usages failed: %w", err)
+	}
+
+	// group by file, for suppression comments and excluded files
+	fileUsages := make(map[string][]deepscan.SymbolUsage)
+	for _, usage := range usages {
+		fileUsages[usage.Place.File] = append(fileUsages[usage.Place.File], usage)
+	}
+
+	for filePath, usages := range fileUsages {
+		file, exist := c.files[filePath]
+		if !exist || c.fileComponents[filePath] != cmp.Name.Value {
+			// excluded file or file of another component
+			continue
+		}
+
+		result, err := c.CheckFileSymbols(c.spec, cmp, file, usages)
+		if err != nil {
+			return fmt.Errorf("failed check file '%s': %w", filePath, err)
+		}
+
+		c.result.Append(result)
+	}
+
+	return nil
+}
+
+// findDeniedSymbolRule returns first component rule, that not allow to use
+// symbol. Deny globs take precedence, not empty allow list deny all other symbols
+func findDeniedSymbolRule(
+	modules common.Modules,
+	component arch.Component,
+	usage deepscan.SymbolUsage,
+) (arch.SymbolRule, bool, error) {
+	resolvedImport := models.ResolvedImport{Name: usage.Import}
+	if require, ok := modules.Require(usage.Import); ok {
+		resolvedImport.Module = require.Path
+	}
+
+	for _, rule := range component.SymbolRules {
+		if !isSymbolRuleMatched(rule, resolvedImport) {
+			continue
+		}
+
+		denied, err := matchAnyGlob(rule.Deny, usage.Name)
+		if err != nil {
+			return arch.SymbolRule{}, false, err
+		}
+
+		if denied {
+			return rule, true, nil
+		}
+
+		if len(rule.Allow) == 0 {
+			continue
+		}
+
+		allowed, err := matchAnyGlob(rule.Allow, usage.Name)
+		if err != nil {
+			return arch.SymbolRule{}, false, err
+		}
+
+		if !allowed {
+			return rule, true, nil
+		}
+	}
+
+	return arch.SymbolRule{}, false, nil
+}
+
+func isSymbolRuleMatched(rule arch.SymbolRule, resolvedImport models.ResolvedImport) bool {
+	for _, projectImport := range rule.ProjectImports {
+		if projectImport.Value.ImportPath == resolvedImport.Name {
+			return true
+		}
+	}
+
+	for _, vendorGlob := range rule.VendorGlobs {
+		if matched, err := vendorGlob.Value.Match(resolvedImport); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
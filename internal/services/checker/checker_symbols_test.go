@@ -0,0 +1,119 @@
+package checker
+
+import (
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/checker/deepscan"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_findDeniedSymbolRule(t *testing.T) {
+	globs := func(list ...string) []common.Referable[models.Glob] {
+		res := make([]common.Referable[models.Glob], 0, len(list))
+		for _, glob := range list {
+			res = append(res, common.NewEmptyReferable(models.Glob(glob)))
+		}
+
+		return res
+	}
+
+	vendorGlobs := func(list ...string) []common.Referable[models.VendorGlob] {
+		res := make([]common.Referable[models.VendorGlob], 0, len(list))
+		for _, glob := range list {
+			res = append(res, common.NewEmptyReferable(models.VendorGlob{Glob: models.Glob(glob)}))
+		}
+
+		return res
+	}
+
+	modelsRule := func(allow, deny []common.Referable[models.Glob]) arch.SymbolRule {
+		return arch.SymbolRule{
+			Target:         common.NewEmptyReferable("models"),
+			Allow:          allow,
+			Deny:           deny,
+			ProjectImports: []common.Referable[models.ResolvedPath]{makeTestResolvedPath("models")},
+		}
+	}
+
+	vendorRule := arch.SymbolRule{
+		Target:      common.NewEmptyReferable("sql"),
+		Deny:        globs("Open*"),
+		VendorGlobs: vendorGlobs("github.com/vendor/lib/**"),
+	}
+
+	usage := func(importPath, name string) deepscan.SymbolUsage {
+		return deepscan.SymbolUsage{Pkg: "pkg", Name: name, Import: importPath}
+	}
+
+	tests := []struct {
+		name       string
+		rules      []arch.SymbolRule
+		usage      deepscan.SymbolUsage
+		wantDenied bool
+		wantTarget string
+	}{
+		{
+			name:  "no rules",
+			usage: usage(testModulePath+"/models", "UserEntity"),
+		},
+		{
+			name:       "denied by glob",
+			rules:      []arch.SymbolRule{modelsRule(nil, globs("*Entity"))},
+			usage:      usage(testModulePath+"/models", "UserEntity"),
+			wantDenied: true,
+			wantTarget: "models",
+		},
+		{
+			name:  "not matched deny glob",
+			rules: []arch.SymbolRule{modelsRule(nil, globs("*Entity"))},
+			usage: usage(testModulePath+"/models", "UserDTO"),
+		},
+		{
+			name:  "rule of other package",
+			rules: []arch.SymbolRule{modelsRule(nil, globs("*Entity"))},
+			usage: usage(testModulePath+"/repository", "UserEntity"),
+		},
+		{
+			name:  "in allow list",
+			rules: []arch.SymbolRule{modelsRule(globs("*DTO", "New*"), nil)},
+			usage: usage(testModulePath+"/models", "NewUserDTO"),
+		},
+		{
+			name:       "not in allow list",
+			rules:      []arch.SymbolRule{modelsRule(globs("*DTO"), nil)},
+			usage:      usage(testModulePath+"/models", "UserEntity"),
+			wantDenied: true,
+			wantTarget: "models",
+		},
+		{
+			name:       "deny take precedence over allow",
+			rules:      []arch.SymbolRule{modelsRule(globs("User*"), globs("*Entity"))},
+			usage:      usage(testModulePath+"/models", "UserEntity"),
+			wantDenied: true,
+			wantTarget: "models",
+		},
+		{
+			name:       "vendor denied by glob",
+			rules:      []arch.SymbolRule{modelsRule(nil, globs("*Entity")), vendorRule},
+			usage:      usage("github.com/vendor/lib/sql", "OpenDB"),
+			wantDenied: true,
+			wantTarget: "sql",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmp := arch.Component{
+				Name:        common.NewEmptyReferable("component"),
+				SymbolRules: tt.rules,
+			}
+
+			rule, denied, err := findDeniedSymbolRule(common.Modules{}, cmp, tt.usage)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantDenied, denied)
+			assert.Equal(t, tt.wantTarget, rule.Target.Value)
+		})
+	}
+}
@@ -44,6 +44,15 @@ type (
 		Definition Source // where this type defined
 	}
 
+	// SymbolUsage is selector expression with identifier
+	// of imported package (example: "models.UserEntity")
+	SymbolUsage struct {
+		Pkg    string           // package name in code (example: "models")
+		Name   string           // used identifier (example: "UserEntity")
+		Import string           // package full import path (example: "example.com/myProject/internal/models")
+		Place  common.Reference // selector expression place in source code
+	}
+
 	Source struct {
 		Pkg    string           // package name (example: "a")
 		Import string           // package full import path (example: "example.com/myProject/internal/a")
@@ -0,0 +1,73 @@
+package deepscan
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	astUtil "github.com/fe3dback/go-arch-lint/internal/services/common/ast"
+)
+
+// SymbolUsages returns all identifiers of imported packages, that used
+// in package code (only production files, tests is not loaded).
+// Share same packages cache with Usages
+func (s *Searcher) SymbolUsages(absPackagePath string) ([]SymbolUsage, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	astPackage, err := cachedPackage(s.ctx, absPackagePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed get package at '%s': %w", absPackagePath, err)
+	}
+
+	if astPackage.TypesInfo == nil {
+		return nil, nil
+	}
+
+	usages := make([]SymbolUsage, 0)
+	for _, file := range astPackage.Syntax {
+		usages = append(usages, FileSymbolUsages(s.ctx.fileSet, file, astPackage.TypesInfo)...)
+	}
+
+	return usages, nil
+}
+
+// FileSymbolUsages find all selector expressions like "models.UserEntity", where
+// left side is imported package name (resolved by types info, so import aliases
+// and local variables with same name as package is handled correctly).
+// Without types info nothing is found
+func FileSymbolUsages(fileSet *token.FileSet, file *ast.File, info *types.Info) []SymbolUsage {
+	usages := make([]SymbolUsage, 0)
+	if info == nil || info.Uses == nil {
+		return usages
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		selector, ok := node.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		ident, ok := selector.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		pkgName, ok := info.Uses[ident].(*types.PkgName)
+		if !ok {
+			return true
+		}
+
+		usages = append(usages, SymbolUsage{
+			Pkg:    ident.Name,
+			Name:   selector.Sel.Name,
+			Import: pkgName.Imported().Path(),
+			Place:  astUtil.PositionFromToken(fileSet.Position(selector.Pos())),
+		})
+
+		return true
+	})
+
+	return usages
+}
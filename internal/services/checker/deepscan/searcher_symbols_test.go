@@ -0,0 +1,42 @@
+package deepscan
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSymbolUsages(t *testing.T) {
+	const src = `package app
+
+import str "strings"
+
+func Upper(strings string) string {
+	return str.ToUpper(strings)
+}
+`
+
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "app.go", src, 0)
+	assert.NoError(t, err)
+
+	info := &types.Info{Uses: make(map[*ast.Ident]types.Object)}
+	_, err = (&types.Config{Importer: importer.Default()}).Check("app", fileSet, []*ast.File{file}, info)
+	assert.NoError(t, err)
+
+	usages := FileSymbolUsages(fileSet, file, info)
+	if assert.Len(t, usages, 1) {
+		assert.Equal(t, "str", usages[0].Pkg)
+		assert.Equal(t, "ToUpper", usages[0].Name)
+		assert.Equal(t, "strings", usages[0].Import)
+	}
+
+	// syntax only mode (types info is not loaded)
+	assert.Empty(t, FileSymbolUsages(fileSet, file, nil))
+	assert.Empty(t, FileSymbolUsages(fileSet, file, &types.Info{}))
+}
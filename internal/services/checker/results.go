@@ -4,6 +4,7 @@ import (
 	"sort"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 )
 
 type (
@@ -14,6 +15,10 @@ func newResults() results {
 	return results{
 		DependencyWarnings: []models.CheckArchWarningDependency{},
 		MatchWarnings:      []models.CheckArchWarningMatch{},
+		ExpiredWarnings:    []models.CheckArchWarningExpired{},
+		StdWarnings:        []models.CheckArchWarningStd{},
+		SuppressedWarnings: []models.CheckSuppressedWarning{},
+		Suppressions:       []models.CheckSuppression{},
 	}
 }
 
@@ -25,6 +30,22 @@ func (res *results) addDependencyWarning(warn models.CheckArchWarningDependency)
 	res.DependencyWarnings = append(res.DependencyWarnings, warn)
 }
 
+func (res *results) addExpiredWarning(warn models.CheckArchWarningExpired) {
+	res.ExpiredWarnings = append(res.ExpiredWarnings, warn)
+}
+
+func (res *results) addStdWarning(warn models.CheckArchWarningStd) {
+	res.StdWarnings = append(res.StdWarnings, warn)
+}
+
+func (res *results) addSuppressedWarning(warn models.CheckSuppressedWarning) {
+	res.SuppressedWarnings = append(res.SuppressedWarnings, warn)
+}
+
+func (res *results) addSuppression(suppression models.CheckSuppression) {
+	res.Suppressions = append(res.Suppressions, suppression)
+}
+
 func (res *results) assembleSortedResults() models.CheckResult {
 	sort.Slice(res.DependencyWarnings, func(i, j int) bool {
 		return res.DependencyWarnings[i].FileRelativePath < res.DependencyWarnings[j].FileRelativePath
@@ -34,8 +55,36 @@ func (res *results) assembleSortedResults() models.CheckResult {
 		return res.MatchWarnings[i].FileRelativePath < res.MatchWarnings[j].FileRelativePath
 	})
 
+	sort.Slice(res.ExpiredWarnings, func(i, j int) bool {
+		return lessReference(res.ExpiredWarnings[i].Reference, res.ExpiredWarnings[j].Reference)
+	})
+
+	sort.Slice(res.StdWarnings, func(i, j int) bool {
+		return lessReference(res.StdWarnings[i].Reference, res.StdWarnings[j].Reference)
+	})
+
+	sort.Slice(res.SuppressedWarnings, func(i, j int) bool {
+		return lessReference(res.SuppressedWarnings[i].Reference, res.SuppressedWarnings[j].Reference)
+	})
+
+	sort.Slice(res.Suppressions, func(i, j int) bool {
+		return lessReference(res.Suppressions[i].Reference, res.Suppressions[j].Reference)
+	})
+
 	return models.CheckResult{
 		DependencyWarnings: res.DependencyWarnings,
 		MatchWarnings:      res.MatchWarnings,
+		ExpiredWarnings:    res.ExpiredWarnings,
+		StdWarnings:        res.StdWarnings,
+		SuppressedWarnings: res.SuppressedWarnings,
+		Suppressions:       res.Suppressions,
 	}
 }
+
+func lessReference(a, b common.Reference) bool {
+	if a.File != b.File {
+		return a.File < b.File
+	}
+
+	return a.Line < b.Line
+}
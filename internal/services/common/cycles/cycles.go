@@ -0,0 +1,165 @@
+package cycles
+
+import "sort"
+
+// Graph is directed graph of nodes (node -> list of dependencies)
+type Graph = map[string][]string
+
+// StronglyConnected find all strongly connected components (Tarjan)
+// with more than one node. Result is deterministic: nodes in each
+// component are sorted, components are sorted by first node.
+func StronglyConnected(graph Graph) [][]string {
+	t := tarjan{
+		graph:   graph,
+		index:   map[string]int{},
+		lowLink: map[string]int{},
+		onStack: map[string]bool{},
+		result:  make([][]string, 0),
+	}
+
+	for _, node := range sortedNodes(graph) {
+		if _, visited := t.index[node]; !visited {
+			t.connect(node)
+		}
+	}
+
+	sort.Slice(t.result, func(i, j int) bool {
+		return t.result[i][0] < t.result[j][0]
+	})
+
+	return t.result
+}
+
+// Chain find shortest path from first node of component and back to it,
+// using only component nodes. Example: [a, b, c, a]
+func Chain(graph Graph, component []string) []string {
+	if len(component) == 0 {
+		return []string{}
+	}
+
+	inComponent := make(map[string]bool, len(component))
+	for _, node := range component {
+		inComponent[node] = true
+	}
+
+	start := component[0]
+	parents := map[string]string{}
+	queue := []string{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, next := range sortedEdges(graph, current) {
+			if !inComponent[next] {
+				continue
+			}
+
+			if next == start {
+				return unwind(parents, start, current)
+			}
+
+			if _, visited := parents[next]; visited {
+				continue
+			}
+
+			parents[next] = current
+			queue = append(queue, next)
+		}
+	}
+
+	return []string{}
+}
+
+func unwind(parents map[string]string, start, last string) []string {
+	chain := []string{start}
+	for node := last; node != start; node = parents[node] {
+		chain = append(chain, node)
+	}
+
+	chain = append(chain, start)
+
+	// chain built from the end: [start, last, ..., start]
+	for i, j := 1, len(chain)-2; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+
+	return chain
+}
+
+type tarjan struct {
+	graph   Graph
+	counter int
+	index   map[string]int
+	lowLink map[string]int
+	stack   []string
+	onStack map[string]bool
+	result  [][]string
+}
+
+func (t *tarjan) connect(node string) {
+	t.index[node] = t.counter
+	t.lowLink[node] = t.counter
+	t.counter++
+
+	t.stack = append(t.stack, node)
+	t.onStack[node] = true
+
+	for _, next := range sortedEdges(t.graph, node) {
+		if _, visited := t.index[next]; !visited {
+			t.connect(next)
+			t.lowLink[node] = minInt(t.lowLink[node], t.lowLink[next])
+		} else if t.onStack[next] {
+			t.lowLink[node] = minInt(t.lowLink[node], t.index[next])
+		}
+	}
+
+	if t.lowLink[node] != t.index[node] {
+		return
+	}
+
+	component := make([]string, 0)
+	for {
+		last := t.stack[len(t.stack)-1]
+		t.stack = t.stack[:len(t.stack)-1]
+		t.onStack[last] = false
+
+		component = append(component, last)
+		if last == node {
+			break
+		}
+	}
+
+	if len(component) < 2 {
+		return
+	}
+
+	sort.Strings(component)
+	t.result = append(t.result, component)
+}
+
+func sortedNodes(graph Graph) []string {
+	nodes := make([]string, 0, len(graph))
+	for node := range graph {
+		nodes = append(nodes, node)
+	}
+
+	sort.Strings(nodes)
+	return nodes
+}
+
+func sortedEdges(graph Graph, node string) []string {
+	edges := make([]string, len(graph[node]))
+	copy(edges, graph[node])
+
+	sort.Strings(edges)
+	return edges
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
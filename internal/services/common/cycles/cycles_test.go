@@ -0,0 +1,67 @@
+package cycles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStronglyConnected(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph Graph
+		want  [][]string
+	}{
+		{
+			name: "no cycles",
+			graph: Graph{
+				"a": {"b", "c"},
+				"b": {"c"},
+			},
+			want: [][]string{},
+		},
+		{
+			name: "self dependency is not cycle",
+			graph: Graph{
+				"a": {"a"},
+			},
+			want: [][]string{},
+		},
+		{
+			name: "two independent cycles",
+			graph: Graph{
+				"d": {"c"},
+				"c": {"d", "a"},
+				"a": {"b"},
+				"b": {"a"},
+			},
+			want: [][]string{{"a", "b"}, {"c", "d"}},
+		},
+		{
+			name: "long cycle",
+			graph: Graph{
+				"a": {"b"},
+				"b": {"c"},
+				"c": {"a", "x"},
+			},
+			want: [][]string{{"a", "b", "c"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, StronglyConnected(tt.graph))
+		})
+	}
+}
+
+func TestChain(t *testing.T) {
+	graph := Graph{
+		"a": {"d", "b"},
+		"b": {"c"},
+		"c": {"a"},
+		"d": {"b"},
+	}
+
+	assert.Equal(t, []string{"a", "b", "c", "a"}, Chain(graph, []string{"a", "b", "c", "d"}))
+	assert.Equal(t, []string{}, Chain(graph, []string{}))
+}
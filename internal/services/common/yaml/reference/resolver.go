@@ -1,25 +1,20 @@
 package reference
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/fe3dback/go-arch-lint/internal/models/common"
 	"github.com/fe3dback/go-yaml"
 	"github.com/fe3dback/go-yaml/parser"
 )
 
-type Resolver struct {
-	cache map[string][]byte
-}
+type Resolver struct{}
 
 func NewResolver() *Resolver {
-	return &Resolver{
-		cache: map[string][]byte{},
-	}
+	return &Resolver{}
 }
 
-func (r *Resolver) Resolve(filePath string, yamlPath string) (ref common.Reference) {
+// Resolve find yamlPath node in arch file source code, filePath
+// is used only as reference file, so source can be not saved on disk
+func (r *Resolver) Resolve(filePath string, sourceCode []byte, yamlPath string) (ref common.Reference) {
 	defer func() {
 		if data := recover(); data != nil {
 			ref = common.NewEmptyReference()
@@ -27,8 +22,6 @@ func (r *Resolver) Resolve(filePath string, yamlPath string) (ref common.Referen
 		}
 	}()
 
-	sourceCode := r.fileSource(filePath)
-
 	path, err := yaml.PathString(yamlPath)
 	if err != nil {
 		return common.NewEmptyReference()
@@ -52,17 +45,3 @@ func (r *Resolver) Resolve(filePath string, yamlPath string) (ref common.Referen
 		pos.Column,
 	)
 }
-
-func (r *Resolver) fileSource(filePath string) []byte {
-	if content, exist := r.cache[filePath]; exist {
-		return content
-	}
-
-	content, err := os.ReadFile(filePath)
-	if err != nil {
-		panic(fmt.Sprintf("failed to provide source code of archfile: %v", err))
-	}
-
-	r.cache[filePath] = content
-	return content
-}
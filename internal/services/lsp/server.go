@@ -0,0 +1,218 @@
+package lsp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/lsp"
+)
+
+const (
+	jsonRPCVersion = "2.0"
+
+	headerContentLength = "Content-Length"
+
+	methodExit = "exit"
+)
+
+type (
+	// Server is json-rpc 2.0 transport for language server protocol,
+	// all messages is processed one by one in order of receiving
+	Server struct{}
+
+	message struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id,omitempty"`
+		Method  string          `json:"method,omitempty"`
+		Params  json.RawMessage `json:"params,omitempty"`
+	}
+
+	response struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Result  any             `json:"result"`
+	}
+
+	errorResponse struct {
+		JSONRPC string            `json:"jsonrpc"`
+		ID      json.RawMessage   `json:"id"`
+		Error   lsp.ResponseError `json:"error"`
+	}
+
+	notification struct {
+		JSONRPC string `json:"jsonrpc"`
+		Method  string `json:"method"`
+		Params  any    `json:"params"`
+	}
+
+	writer struct {
+		out io.Writer
+		mux sync.Mutex
+	}
+)
+
+func NewServer() *Server {
+	return &Server{}
+}
+
+// Serve will read client messages from in and write server messages into out,
+// until client send 'exit' notification, close input stream or ctx is done
+func (s *Server) Serve(ctx context.Context, in io.Reader, out io.Writer, handler lsp.Handler) error {
+	reader := bufio.NewReader(in)
+	w := &writer{out: out}
+
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		content, err := readMessage(reader)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return fmt.Errorf("failed to read message: %w", err)
+		}
+
+		msg := message{}
+		err = json.Unmarshal(content, &msg)
+		if err != nil {
+			err = w.write(errorResponse{
+				JSONRPC: jsonRPCVersion,
+				ID:      json.RawMessage("null"),
+				Error:   lsp.ResponseError{Code: lsp.CodeParseError, Message: err.Error()},
+			})
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if msg.Method == methodExit {
+			return nil
+		}
+
+		err = s.process(ctx, w, msg, handler)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) process(ctx context.Context, w *writer, msg message, handler lsp.Handler) error {
+	notify := func(method string, params any) error {
+		return w.write(notification{
+			JSONRPC: jsonRPCVersion,
+			Method:  method,
+			Params:  params,
+		})
+	}
+
+	result, err := handler(ctx, msg.Method, msg.Params, notify)
+
+	if len(msg.ID) == 0 {
+		// notification, client not expect any response
+		var respErr lsp.ResponseError
+		if err != nil && !errors.As(err, &respErr) {
+			return notify("window/showMessage", lsp.ShowMessageParams{
+				Type:    lsp.MessageTypeError,
+				Message: err.Error(),
+			})
+		}
+
+		return nil
+	}
+
+	if err != nil {
+		var respErr lsp.ResponseError
+		if !errors.As(err, &respErr) {
+			respErr = lsp.ResponseError{Code: lsp.CodeInternalError, Message: err.Error()}
+		}
+
+		return w.write(errorResponse{
+			JSONRPC: jsonRPCVersion,
+			ID:      msg.ID,
+			Error:   respErr,
+		})
+	}
+
+	return w.write(response{
+		JSONRPC: jsonRPCVersion,
+		ID:      msg.ID,
+		Result:  result,
+	})
+}
+
+// readMessage read one message with base protocol headers:
+// Content-Length: 42\r\n
+// \r\n
+// {...}
+func readMessage(reader *bufio.Reader) ([]byte, error) {
+	contentLength := -1
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			if contentLength == -1 {
+				// empty lines between messages
+				continue
+			}
+
+			break
+		}
+
+		name, value, found := strings.Cut(line, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid header '%s'", line)
+		}
+
+		if !strings.EqualFold(strings.TrimSpace(name), headerContentLength) {
+			// Content-Type and other headers is not used
+			continue
+		}
+
+		contentLength, err = strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || contentLength < 0 {
+			return nil, fmt.Errorf("invalid header '%s'", line)
+		}
+	}
+
+	content := make([]byte, contentLength)
+	_, err := io.ReadFull(reader, content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read message content: %w", err)
+	}
+
+	return content, nil
+}
+
+func (w *writer) write(msg any) error {
+	content, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	_, err = fmt.Fprintf(w.out, "%s: %d\r\n\r\n%s", headerContentLength, len(content), content)
+	if err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+
+	return nil
+}
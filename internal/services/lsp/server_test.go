@@ -0,0 +1,92 @@
+package lsp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/lsp"
+	"github.com/stretchr/testify/assert"
+)
+
+func frame(content string) string {
+	return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(content), content)
+}
+
+func TestServer_Serve(t *testing.T) {
+	handler := func(_ context.Context, method string, params json.RawMessage, notify lsp.Notify) (any, error) {
+		switch method {
+		case "ping":
+			return "pong", nil
+		case "echo":
+			return nil, notify("echo", params)
+		case "fail":
+			return nil, fmt.Errorf("broken")
+		default:
+			return nil, lsp.NewMethodNotFoundError(method)
+		}
+	}
+
+	tests := []struct {
+		name string
+		in   []string
+		want []string
+	}{
+		{
+			name: "request",
+			in:   []string{`{"jsonrpc":"2.0","id":1,"method":"ping"}`},
+			want: []string{`{"jsonrpc":"2.0","id":1,"result":"pong"}`},
+		},
+		{
+			name: "notification",
+			in:   []string{`{"jsonrpc":"2.0","method":"echo","params":{"a":1}}`},
+			want: []string{`{"jsonrpc":"2.0","method":"echo","params":{"a":1}}`},
+		},
+		{
+			name: "unknown notification ignored",
+			in:   []string{`{"jsonrpc":"2.0","method":"$/cancelRequest"}`},
+			want: []string{},
+		},
+		{
+			name: "errors",
+			in: []string{
+				`{"jsonrpc":"2.0","id":"a","method":"unknown"}`,
+				`{"jsonrpc":"2.0","id":"b","method":"fail"}`,
+			},
+			want: []string{
+				`{"jsonrpc":"2.0","id":"a","error":{"code":-32601,"message":"method 'unknown' not supported"}}`,
+				`{"jsonrpc":"2.0","id":"b","error":{"code":-32603,"message":"broken"}}`,
+			},
+		},
+		{
+			name: "stop on exit",
+			in: []string{
+				`{"jsonrpc":"2.0","method":"exit"}`,
+				`{"jsonrpc":"2.0","id":1,"method":"ping"}`,
+			},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := strings.Builder{}
+			for _, content := range tt.in {
+				in.WriteString(frame(content))
+			}
+
+			want := strings.Builder{}
+			for _, content := range tt.want {
+				want.WriteString(frame(content))
+			}
+
+			out := bytes.Buffer{}
+			err := NewServer().Serve(context.Background(), strings.NewReader(in.String()), &out, handler)
+
+			assert.NoError(t, err)
+			assert.Equal(t, want.String(), out.String())
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package holder
 
 import (
+	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 )
 
 type (
@@ -23,6 +26,49 @@ func NewHolder() *Holder {
 }
 
 func (h *Holder) HoldProjectFiles(files []models.ProjectFile, components []arch.Component) []models.FileHold {
+	matchedCount, mapping, backMapping := matchFiles(files, components)
+
+	results := make([]models.FileHold, 0)
+	for filePath, componentIDs := range mapping {
+		holder, _ := chooseHolder(componentIDs, matchedCount)
+		results = append(results, fileHold(backMapping[filePath], components, holder))
+	}
+
+	return results
+}
+
+// Explain will return all components, that match file, and steps
+// of choosing one of them (same as HoldProjectFiles), because of files
+// count heuristic, it's still depend on all project files
+func (h *Holder) Explain(files []models.ProjectFile, components []arch.Component, filePath string) models.FileHoldExplain {
+	matchedCount, mapping, backMapping := matchFiles(files, components)
+
+	// components order is not stable, but choosing is not depend on it
+	componentIDs := append([]string{}, mapping[filePath]...)
+	sort.Strings(componentIDs)
+
+	holder, tieBreaks := chooseHolder(componentIDs, matchedCount)
+
+	candidates := make([]models.HoldCandidate, 0, len(componentIDs))
+	for _, componentID := range componentIDs {
+		candidates = append(candidates, models.HoldCandidate{
+			ComponentName: componentID,
+			FilesCount:    matchedCount[componentID],
+			Paths:         componentMatchedPaths(filepath.Dir(filePath), components, componentID),
+		})
+	}
+
+	return models.FileHoldExplain{
+		Hold:       fileHold(backMapping[filePath], components, holder),
+		Candidates: candidates,
+		TieBreaks:  tieBreaks,
+	}
+}
+
+func matchFiles(
+	files []models.ProjectFile,
+	components []arch.Component,
+) (map[string]int, map[string][]string, map[string]models.ProjectFile) {
 	matchedCount := make(map[string]int)
 	// example:
 	// /** 			= 100
@@ -54,70 +100,114 @@ func (h *Holder) HoldProjectFiles(files []models.ProjectFile, components []arch.
 		}
 	}
 
-	results := make([]models.FileHold, 0)
-	for filePath, componentIDs := range mapping {
-		if len(componentIDs) == 0 {
-			results = append(results, models.FileHold{
-				File:        backMapping[filePath],
-				ComponentID: nil,
-			})
+	return matchedCount, mapping, backMapping
+}
 
-			continue
-		}
+// chooseHolder returns best component of file (nil, when file not matched)
+// with human-readable tie-breaking steps
+func chooseHolder(componentIDs []string, matchedCount map[string]int) (*matchedComponent, []string) {
+	tieBreaks := make([]string, 0)
 
-		defComponent := componentIDs[0]
-		holder := matchedComponent{
-			id:         defComponent,
-			filesCount: matchedCount[defComponent],
-		}
+	if len(componentIDs) == 0 {
+		return nil, tieBreaks
+	}
+
+	defComponent := componentIDs[0]
+	holder := matchedComponent{
+		id:         defComponent,
+		filesCount: matchedCount[defComponent],
+	}
+
+	if len(componentIDs) > 1 {
+		for _, componentID := range componentIDs {
+			variant := matchedComponent{
+				id:         componentID,
+				filesCount: matchedCount[componentID],
+			}
+
+			if variant.id == holder.id {
+				continue
+			}
+
+			better, reason := compareWithReason(holder, variant)
+			winner := holder.id
+			if better {
+				winner = variant.id
+			}
 
-		if len(componentIDs) > 1 {
-			for _, componentID := range componentIDs {
-				variant := matchedComponent{
-					id:         componentID,
-					filesCount: matchedCount[componentID],
-				}
+			tieBreaks = append(tieBreaks, fmt.Sprintf("%s vs %s: %s wins, %s",
+				holder.id,
+				variant.id,
+				winner,
+				reason,
+			))
 
-				if compare(holder, variant) {
-					holder = variant
-				}
+			if better {
+				holder = variant
 			}
 		}
+	}
 
-		results = append(results, models.FileHold{
-			File:        backMapping[filePath],
-			ComponentID: &holder.id,
-		})
+	return &holder, tieBreaks
+}
+
+func fileHold(file models.ProjectFile, components []arch.Component, holder *matchedComponent) models.FileHold {
+	if holder == nil {
+		return models.FileHold{
+			File:        file,
+			ComponentID: nil,
+		}
 	}
 
-	return results
+	return models.FileHold{
+		File:        file,
+		ComponentID: &holder.id,
+		Captures:    componentCaptures(filepath.Dir(file.Path), components, holder.id),
+	}
 }
 
 // should return true if B better than A
 func compare(a, b matchedComponent) bool {
+	better, _ := compareWithReason(a, b)
+	return better
+}
+
+// compareWithReason is compare, that also describe deciding criterion
+func compareWithReason(a, b matchedComponent) (bool, string) {
 	if a.id == b.id {
-		return false
+		return false, "same component"
 	}
 
 	// smallest files match count
 	if b.filesCount != a.filesCount {
-		return b.filesCount < a.filesCount
+		return b.filesCount < a.filesCount, fmt.Sprintf("fewer matched files (%d vs %d)",
+			a.filesCount,
+			b.filesCount,
+		)
 	}
 
 	// has more specified directory
 	aLen := strings.Count(a.id, "/")
 	bLen := strings.Count(b.id, "/")
 	if bLen != aLen {
-		return bLen > aLen
+		return bLen > aLen, fmt.Sprintf("same files count (%d), deeper name path ('/' count: %d vs %d)",
+			a.filesCount,
+			aLen,
+			bLen,
+		)
 	}
 
 	// longest name
 	if len(b.id) != len(a.id) {
-		return len(b.id) > len(a.id)
+		return len(b.id) > len(a.id), fmt.Sprintf("same files count (%d) and depth, longer name (%d vs %d)",
+			a.filesCount,
+			len(a.id),
+			len(b.id),
+		)
 	}
 
 	// stable sort for equal priority path's
-	return b.id < a.id
+	return b.id < a.id, "equal priority, first by name sorting"
 }
 
 func componentsMatchesFile(filePath string, components []arch.Component) []string {
@@ -144,6 +234,47 @@ func componentMatchPackage(packagePath string, component arch.Component) bool {
 	return false
 }
 
+// componentMatchedPaths returns component resolved paths, that match package
+func componentMatchedPaths(
+	packagePath string,
+	components []arch.Component,
+	componentID string,
+) []common.Referable[models.ResolvedPath] {
+	matched := make([]common.Referable[models.ResolvedPath], 0)
+
+	for _, component := range components {
+		if component.Name.Value != componentID {
+			continue
+		}
+
+		for _, componentDirectoryRef := range component.ResolvedPaths {
+			if packageMathPath(packagePath, componentDirectoryRef.Value.AbsPath) {
+				matched = append(matched, componentDirectoryRef)
+			}
+		}
+	}
+
+	return matched
+}
+
+// componentCaptures returns named wildcards values of component
+// path, that match package (nil when component path without captures)
+func componentCaptures(packagePath string, components []arch.Component, componentID string) map[string]string {
+	for _, component := range components {
+		if component.Name.Value != componentID {
+			continue
+		}
+
+		for _, componentDirectoryRef := range component.ResolvedPaths {
+			if packageMathPath(packagePath, componentDirectoryRef.Value.AbsPath) {
+				return componentDirectoryRef.Value.Captures
+			}
+		}
+	}
+
+	return nil
+}
+
 func packageMathPath(packagePath string, resolvedPackagePath string) bool {
 	return packagePath == resolvedPackagePath
 }
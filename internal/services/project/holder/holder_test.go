@@ -286,3 +286,51 @@ func Test_compare(t *testing.T) {
 		})
 	}
 }
+
+func TestHolder_Explain(t *testing.T) {
+	component := func(name string, paths ...string) arch.Component {
+		resolved := make([]common.Referable[models.ResolvedPath], 0, len(paths))
+		for _, path := range paths {
+			resolved = append(resolved, common.NewReferable(
+				models.ResolvedPath{AbsPath: path, Glob: models.Glob(path)},
+				common.NewEmptyReference(),
+			))
+		}
+
+		return arch.Component{
+			Name:          common.NewReferable(name, common.NewEmptyReference()),
+			ResolvedPaths: resolved,
+		}
+	}
+
+	components := []arch.Component{
+		component("all", "/app", "/app/billing"),
+		component("billing", "/app/billing"),
+	}
+
+	files := []models.ProjectFile{
+		{Path: "/app/main.go"},
+		{Path: "/app/billing/invoice.go"},
+		{Path: "/app/billing/payment.go"},
+	}
+
+	explain := NewHolder().Explain(files, components, "/app/billing/invoice.go")
+
+	if explain.Hold.ComponentID == nil || *explain.Hold.ComponentID != "billing" {
+		t.Fatalf("Explain() hold = %v, want billing", explain.Hold.ComponentID)
+	}
+
+	if len(explain.Candidates) != 2 || explain.Candidates[0].FilesCount != 3 || explain.Candidates[1].FilesCount != 2 {
+		t.Errorf("Explain() candidates = %+v", explain.Candidates)
+	}
+
+	wantTieBreaks := []string{"all vs billing: billing wins, fewer matched files (3 vs 2)"}
+	if !reflect.DeepEqual(explain.TieBreaks, wantTieBreaks) {
+		t.Errorf("Explain() tie breaks = %v, want %v", explain.TieBreaks, wantTieBreaks)
+	}
+
+	notMatched := NewHolder().Explain(files, components[1:], "/app/main.go")
+	if notMatched.Hold.ComponentID != nil || len(notMatched.Candidates) != 0 {
+		t.Errorf("Explain() not matched file = %+v", notMatched)
+	}
+}
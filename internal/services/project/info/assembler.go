@@ -19,17 +19,34 @@ func NewAssembler() *Assembler {
 }
 
 func (a *Assembler) ProjectInfo(rootDirectory string, archFilePath string) (common.Project, error) {
-	projectPath, err := filepath.Abs(rootDirectory)
+	project, err := a.ModuleInfo(rootDirectory)
 	if err != nil {
-		return common.Project{}, fmt.Errorf("failed to resolve abs path '%s'", rootDirectory)
+		return common.Project{}, err
 	}
 
 	// check arch file
-	goArchFilePath, err := resolveArchPath(projectPath, archFilePath)
+	goArchFilePath, err := resolveArchPath(project.Directory, archFilePath)
 	if err != nil {
 		return common.Project{}, err
 	}
 
+	project.GoArchFilePath = goArchFilePath
+	return project, nil
+}
+
+// ModuleInfo is same as ProjectInfo, but not require arch file existence
+func (a *Assembler) ModuleInfo(rootDirectory string) (common.Project, error) {
+	projectPath, err := filepath.Abs(rootDirectory)
+	if err != nil {
+		return common.Project{}, fmt.Errorf("failed to resolve abs path '%s'", rootDirectory)
+	}
+
+	// go.work has priority over go.mod, same as in go tool
+	goWorkFilePath := filepath.Join(projectPath, models.DefaultGoWorkFileName)
+	if _, err = os.Stat(goWorkFilePath); err == nil {
+		return workspaceInfo(projectPath, goWorkFilePath)
+	}
+
 	// check go.mod
 	goModFilePath := filepath.Clean(fmt.Sprintf("%s/%s", projectPath, models.DefaultGoModFileName))
 	_, err = os.Stat(goModFilePath)
@@ -41,31 +58,57 @@ func (a *Assembler) ProjectInfo(rootDirectory string, archFilePath string) (comm
 	}
 
 	// parse go.mod
-	moduleName, err := checkCmdExtractModuleName(goModFilePath)
+	module, err := checkCmdExtractModule(goModFilePath, projectPath)
 	if err != nil {
 		return common.Project{}, fmt.Errorf("failed get module name: %w", err)
 	}
 
 	return common.Project{
-		Directory:      projectPath,
-		GoArchFilePath: goArchFilePath,
-		GoModFilePath:  goModFilePath,
-		ModuleName:     moduleName,
+		Directory:     projectPath,
+		GoModFilePath: goModFilePath,
+		ModuleName:    module.Name,
+		Modules:       common.Modules{module},
 	}, nil
 }
 
-func checkCmdExtractModuleName(goModPath string) (string, error) {
+// ModuleRequires returns all required modules paths from go.mod
+func (a *Assembler) ModuleRequires(goModFilePath string) ([]string, error) {
+	goModFile, err := checkCmdParseGoModFile(goModFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("can`t parse gomod: %w", err)
+	}
+
+	requires := make([]string, 0, len(goModFile.Require))
+	for _, require := range goModFile.Require {
+		requires = append(requires, require.Mod.Path)
+	}
+
+	return requires, nil
+}
+
+func checkCmdExtractModule(goModPath string, directory string) (common.Module, error) {
 	goModFile, err := checkCmdParseGoModFile(goModPath)
 	if err != nil {
-		return "", fmt.Errorf("can`t parse gomod: %w", err)
+		return common.Module{}, fmt.Errorf("can`t parse gomod: %w", err)
 	}
 
-	moduleName := goModFile.Module.Mod.Path
-	if moduleName == "" {
-		return "", fmt.Errorf("%s should contain module name in 'module'", models.DefaultGoModFileName)
+	if goModFile.Module == nil || goModFile.Module.Mod.Path == "" {
+		return common.Module{}, fmt.Errorf("%s should contain module name in 'module'", models.DefaultGoModFileName)
 	}
 
-	return moduleName, nil
+	requires := make([]common.Require, 0, len(goModFile.Require))
+	for _, require := range goModFile.Require {
+		requires = append(requires, common.Require{
+			Path:    require.Mod.Path,
+			Version: require.Mod.Version,
+		})
+	}
+
+	return common.Module{
+		Name:      goModFile.Module.Mod.Path,
+		Directory: directory,
+		Requires:  requires,
+	}, nil
 }
 
 func checkCmdParseGoModFile(path string) (*modfile.File, error) {
@@ -0,0 +1,89 @@
+package info
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+
+	"golang.org/x/mod/modfile"
+)
+
+// workspaceInfo returns project with all modules from go.work 'use' list,
+// all modules should be inside of workspace directory, because
+// components paths in arch file are relative to it
+func workspaceInfo(projectPath string, goWorkFilePath string) (common.Project, error) {
+	content, err := os.ReadFile(goWorkFilePath)
+	if err != nil {
+		return common.Project{}, fmt.Errorf("failed to read '%s': %w", goWorkFilePath, err)
+	}
+
+	work, err := modfile.ParseWork(goWorkFilePath, content, nil)
+	if err != nil {
+		return common.Project{}, fmt.Errorf("failed to parse '%s': %w", goWorkFilePath, err)
+	}
+
+	if len(work.Use) == 0 {
+		return common.Project{}, fmt.Errorf("'%s' should contain at least one module in 'use'", goWorkFilePath)
+	}
+
+	project := common.Project{
+		Directory: projectPath,
+		Modules:   make(common.Modules, 0, len(work.Use)),
+	}
+
+	for _, use := range work.Use {
+		moduleDirectory := filepath.Clean(filepath.Join(projectPath, filepath.FromSlash(use.Path)))
+		if filepath.IsAbs(use.Path) {
+			moduleDirectory = filepath.Clean(use.Path)
+		}
+
+		if moduleDirectory != projectPath && !strings.HasPrefix(moduleDirectory, projectPath+string(filepath.Separator)) {
+			return common.Project{}, fmt.Errorf("workspace module '%s' is outside of project directory '%s'", use.Path, projectPath)
+		}
+
+		goModFilePath := filepath.Join(moduleDirectory, models.DefaultGoModFileName)
+		module, err := checkCmdExtractModule(goModFilePath, moduleDirectory)
+		if err != nil {
+			return common.Project{}, fmt.Errorf("failed get module name of '%s': %w", use.Path, err)
+		}
+
+		project.Modules = append(project.Modules, module)
+
+		if moduleDirectory == projectPath || project.GoModFilePath == "" {
+			project.GoModFilePath = goModFilePath
+		}
+	}
+
+	project.ModuleName = workspaceName(project)
+	return project, nil
+}
+
+// workspaceName is name of module in workspace root, or
+// common import path prefix of all workspace modules
+func workspaceName(project common.Project) string {
+	if module, found := project.Modules.ByPath(project.Directory); found {
+		return module.Name
+	}
+
+	prefix := strings.Split(project.Modules[0].Name, "/")
+	for _, module := range project.Modules[1:] {
+		parts := strings.Split(module.Name, "/")
+
+		same := 0
+		for same < len(prefix) && same < len(parts) && prefix[same] == parts[same] {
+			same++
+		}
+
+		prefix = prefix[:same]
+	}
+
+	if len(prefix) == 0 {
+		return models.DefaultGoWorkFileName
+	}
+
+	return strings.Join(prefix, "/")
+}
@@ -33,14 +33,24 @@ func (r *Resolver) ProjectFiles(ctx context.Context, spec arch.Spec) ([]models.F
 	projectFiles, err := r.projectFilesResolver.Scan(
 		ctx,
 		scanDirectory,
-		spec.ModuleName.Value,
+		spec.Modules,
 		refPathToList(spec.Exclude),
 		refRegExpToList(spec.ExcludeFilesMatcher),
+		models.BuildContext{
+			Enabled: spec.Build.Enabled,
+			GOOS:    spec.Build.GOOS.Value,
+			GOARCH:  spec.Build.GOARCH.Value,
+			TagSets: spec.Build.TagSets,
+		},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve project files: %w", err)
 	}
 
+	if spec.Allow.IgnoreTests.Value {
+		projectFiles = withoutTests(projectFiles)
+	}
+
 	holdFiles := r.projectFilesHolder.HoldProjectFiles(projectFiles, spec.Components)
 	return holdFiles, nil
 }
@@ -26,3 +26,16 @@ func refRegExpToList(list []common.Referable[*regexp.Regexp]) []*regexp.Regexp {
 
 	return result
 }
+
+func withoutTests(files []models.ProjectFile) []models.ProjectFile {
+	filtered := make([]models.ProjectFile, 0, len(files))
+	for _, file := range files {
+		if file.IsTest() {
+			continue
+		}
+
+		filtered = append(filtered, file)
+	}
+
+	return filtered
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 )
 
 type (
@@ -13,9 +14,10 @@ type (
 		Scan(
 			ctx context.Context,
 			projectDirectory string,
-			moduleName string,
+			modules common.Modules,
 			excludePaths []models.ResolvedPath,
 			excludeFileMatchers []*regexp.Regexp,
+			buildContext models.BuildContext,
 		) ([]models.ProjectFile, error)
 	}
 
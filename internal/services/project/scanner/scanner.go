@@ -4,14 +4,18 @@ import (
 	"context"
 	"fmt"
 	"go/ast"
+	"go/build"
+	"go/build/constraint"
 	"go/parser"
 	"go/token"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 	astUtil "github.com/fe3dback/go-arch-lint/internal/services/common/ast"
 	"golang.org/x/tools/go/packages"
 )
@@ -19,13 +23,26 @@ import (
 type (
 	Scanner struct {
 		stdPackages map[string]struct{}
+
+		// parsed files from previous scans, for watch mode
+		// only changed files will be parsed again
+		cache map[string]cachedFile
+	}
+
+	cachedFile struct {
+		modTime time.Time
+		size    int64
+		modules string
+		file    models.ProjectFile
 	}
 
 	resolveContext struct {
 		projectDirectory    string
-		moduleName          string
+		modules             common.Modules
+		modulesKey          string
 		excludePaths        []models.ResolvedPath
 		excludeFileMatchers []*regexp.Regexp
+		buildContexts       []build.Context
 
 		tokenSet *token.FileSet
 		results  []models.ProjectFile
@@ -35,6 +52,7 @@ type (
 func NewScanner() *Scanner {
 	scanner := &Scanner{
 		stdPackages: make(map[string]struct{}, 255),
+		cache:       make(map[string]cachedFile),
 	}
 
 	stdPackages, err := packages.Load(nil, "std")
@@ -50,23 +68,31 @@ func NewScanner() *Scanner {
 }
 
 func (r *Scanner) Scan(
-	_ context.Context,
+	ctx context.Context,
 	projectDirectory string,
-	moduleName string,
+	modules common.Modules,
 	excludePaths []models.ResolvedPath,
 	excludeFileMatchers []*regexp.Regexp,
+	buildContext models.BuildContext,
 ) ([]models.ProjectFile, error) {
 	rctx := resolveContext{
 		projectDirectory:    projectDirectory,
-		moduleName:          moduleName,
+		modules:             modules,
+		modulesKey:          modulesKey(modules),
 		excludePaths:        excludePaths,
 		excludeFileMatchers: excludeFileMatchers,
+		buildContexts:       buildContexts(buildContext),
 
 		tokenSet: token.NewFileSet(),
 		results:  []models.ProjectFile{},
 	}
 
 	err := filepath.Walk(rctx.projectDirectory, func(path string, info os.FileInfo, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			// big projects can be scanned for a long time
+			return ctxErr
+		}
+
 		return r.resolveFile(&rctx, path, info, err)
 	})
 	if err != nil {
@@ -76,6 +102,26 @@ func (r *Scanner) Scan(
 	return rctx.results, nil
 }
 
+// FileImports resolve imports of already parsed file (from go/analysis pass)
+func (r *Scanner) FileImports(tokenSet *token.FileSet, fileAst *ast.File, modules common.Modules) []models.ResolvedImport {
+	return r.extractImports(&resolveContext{
+		modules:  modules,
+		tokenSet: tokenSet,
+	}, fileAst)
+}
+
+// FileSuppressions returns all suppression comments of already parsed file (from go/analysis pass)
+func (r *Scanner) FileSuppressions(tokenSet *token.FileSet, fileAst *ast.File) []models.Suppression {
+	sourceCode, err := os.ReadFile(tokenSet.File(fileAst.Pos()).Name())
+	if err != nil {
+		return []models.Suppression{}
+	}
+
+	return r.extractSuppressions(&resolveContext{
+		tokenSet: tokenSet,
+	}, fileAst, sourceCode)
+}
+
 func (r *Scanner) resolveFile(ctx *resolveContext, path string, info os.FileInfo, err error) error {
 	if err != nil {
 		return err
@@ -85,7 +131,12 @@ func (r *Scanner) resolveFile(ctx *resolveContext, path string, info os.FileInfo
 		return nil
 	}
 
-	return r.parse(ctx, path)
+	if cached, ok := r.cache[path]; ok && cached.actual(ctx, info) {
+		ctx.results = append(ctx.results, cached.file)
+		return nil
+	}
+
+	return r.parse(ctx, path, info)
 }
 
 func (r *Scanner) inScope(ctx *resolveContext, path string) bool {
@@ -105,20 +156,64 @@ func (r *Scanner) inScope(ctx *resolveContext, path string) bool {
 		}
 	}
 
-	return true
+	return r.matchBuild(ctx, path)
+}
+
+// matchBuild check file build constraints (file name suffixes and "//go:build" line),
+// file is in scope, when it matches at least one of configured tag sets
+func (r *Scanner) matchBuild(ctx *resolveContext, path string) bool {
+	if len(ctx.buildContexts) == 0 {
+		return true
+	}
+
+	dir, name := filepath.Split(path)
+	for _, buildContext := range ctx.buildContexts {
+		match, err := buildContext.MatchFile(dir, name)
+		if err != nil {
+			// broken file will be reported by parser
+			return true
+		}
+
+		if match {
+			return true
+		}
+	}
+
+	return false
 }
 
-func (r *Scanner) parse(ctx *resolveContext, path string) error {
-	fileAst, err := parser.ParseFile(ctx.tokenSet, path, nil, parser.ImportsOnly)
+func (r *Scanner) parse(ctx *resolveContext, path string, info os.FileInfo) error {
+	sourceCode, err := os.ReadFile(path)
 	if err != nil {
-		return fmt.Errorf("failed to parse go source code at '%s': %w", path, err)
+		return fmt.Errorf("failed to read go source code at '%s': %w", path, err)
 	}
 
-	ctx.results = append(ctx.results, models.ProjectFile{
-		Path:    path,
-		Imports: r.extractImports(ctx, fileAst),
-	})
+	// full file is parsed only for suppression comments,
+	// so broken code after imports is still not a problem
+	fileAst, err := parser.ParseFile(ctx.tokenSet, path, sourceCode, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		fileAst, err = parser.ParseFile(ctx.tokenSet, path, sourceCode, parser.ImportsOnly|parser.ParseComments)
+		if err != nil {
+			return fmt.Errorf("failed to parse go source code at '%s': %w", path, err)
+		}
+	}
+
+	file := models.ProjectFile{
+		Path:         path,
+		Kind:         fileKind(path, fileAst),
+		BuildTags:    fileBuildTags(fileAst),
+		Imports:      r.extractImports(ctx, fileAst),
+		Suppressions: r.extractSuppressions(ctx, fileAst, sourceCode),
+	}
 
+	r.cache[path] = cachedFile{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+		modules: ctx.modulesKey,
+		file:    file,
+	}
+
+	ctx.results = append(ctx.results, file)
 	return nil
 }
 
@@ -127,24 +222,197 @@ func (r *Scanner) extractImports(ctx *resolveContext, fileAst *ast.File) []model
 
 	for _, goImport := range fileAst.Imports {
 		importPath := strings.Trim(goImport.Path.Value, "\"")
-		imports = append(imports, models.ResolvedImport{
+		resolvedImport := models.ResolvedImport{
 			Name:       importPath,
 			ImportType: r.getImportType(ctx, importPath),
 			Reference:  astUtil.PositionFromToken(ctx.tokenSet.Position(goImport.Pos())),
-		})
+		}
+
+		if resolvedImport.ImportType == models.ImportTypeVendor {
+			if require, ok := ctx.modules.Require(importPath); ok {
+				resolvedImport.Module = require.Path
+			}
+		}
+
+		imports = append(imports, resolvedImport)
 	}
 
 	return imports
 }
 
+func (r *Scanner) extractSuppressions(ctx *resolveContext, fileAst *ast.File, sourceCode []byte) []models.Suppression {
+	suppressions := make([]models.Suppression, 0)
+
+	for _, group := range fileAst.Comments {
+		for _, comment := range group.List {
+			reason, ok := suppressionReason(comment.Text)
+			if !ok {
+				continue
+			}
+
+			pos := ctx.tokenSet.Position(comment.Pos())
+			line := pos.Line
+
+			// comment on own line, will suppress next code line
+			lineStart := pos.Offset - (pos.Column - 1)
+			if lineStart >= 0 && strings.TrimSpace(string(sourceCode[lineStart:pos.Offset])) == "" {
+				line = ctx.tokenSet.Position(group.End()).Line + 1
+			}
+
+			suppressions = append(suppressions, models.Suppression{
+				Reason:    reason,
+				Line:      line,
+				Reference: astUtil.PositionFromToken(pos),
+			})
+		}
+	}
+
+	return suppressions
+}
+
+// fileKind classify test files by file name, and
+// external test packages by "_test" package name suffix
+func fileKind(path string, fileAst *ast.File) models.FileKind {
+	if !strings.HasSuffix(path, "_test.go") {
+		return models.FileKindProduction
+	}
+
+	if strings.HasSuffix(fileAst.Name.Name, "_test") {
+		return models.FileKindExternalTest
+	}
+
+	return models.FileKindTest
+}
+
+// fileBuildTags returns all not negated tags from file build
+// constraint, "//go:build integration && !race" -> [integration]
+func fileBuildTags(fileAst *ast.File) []string {
+	tags := make([]string, 0)
+
+	for _, group := range fileAst.Comments {
+		if group.Pos() >= fileAst.Package {
+			break
+		}
+
+		for _, comment := range group.List {
+			if !constraint.IsGoBuild(comment.Text) && !constraint.IsPlusBuild(comment.Text) {
+				continue
+			}
+
+			expr, err := constraint.Parse(comment.Text)
+			if err != nil {
+				continue
+			}
+
+			tags = appendTags(tags, expr, false)
+		}
+	}
+
+	return tags
+}
+
+func appendTags(tags []string, expr constraint.Expr, negated bool) []string {
+	switch e := expr.(type) {
+	case *constraint.TagExpr:
+		if negated {
+			return tags
+		}
+
+		for _, tag := range tags {
+			if tag == e.Tag {
+				return tags
+			}
+		}
+
+		return append(tags, e.Tag)
+	case *constraint.NotExpr:
+		return appendTags(tags, e.X, !negated)
+	case *constraint.AndExpr:
+		return appendTags(appendTags(tags, e.X, negated), e.Y, negated)
+	case *constraint.OrExpr:
+		return appendTags(appendTags(tags, e.X, negated), e.Y, negated)
+	default:
+		return tags
+	}
+}
+
+// buildContexts returns go build context for each tag set
+func buildContexts(settings models.BuildContext) []build.Context {
+	if !settings.Enabled {
+		return nil
+	}
+
+	tagSets := settings.TagSets
+	if len(tagSets) == 0 {
+		tagSets = [][]string{{}}
+	}
+
+	contexts := make([]build.Context, 0, len(tagSets))
+	for _, tags := range tagSets {
+		buildContext := build.Default
+		buildContext.BuildTags = tags
+		buildContext.CgoEnabled = true
+
+		if settings.GOOS != "" {
+			buildContext.GOOS = settings.GOOS
+		}
+
+		if settings.GOARCH != "" {
+			buildContext.GOARCH = settings.GOARCH
+		}
+
+		contexts = append(contexts, buildContext)
+	}
+
+	return contexts
+}
+
+// suppressionReason parse "//go-arch-lint:ignore <reason>" comment
+func suppressionReason(comment string) (string, bool) {
+	if !strings.HasPrefix(comment, models.SuppressionDirective) {
+		return "", false
+	}
+
+	reason := strings.TrimPrefix(comment, models.SuppressionDirective)
+	if reason != "" && reason[0] != ' ' && reason[0] != '\t' {
+		// another directive, like "//go-arch-lint:ignored"
+		return "", false
+	}
+
+	return strings.TrimSpace(reason), true
+}
+
 func (r *Scanner) getImportType(ctx *resolveContext, importPath string) models.ImportType {
 	if _, ok := r.stdPackages[importPath]; ok {
 		return models.ImportTypeStdLib
 	}
 
-	if strings.HasPrefix(importPath, ctx.moduleName) {
+	// in workspace mode, imports between all
+	// workspace modules is project imports
+	if _, ok := ctx.modules.ByImportPath(importPath); ok {
 		return models.ImportTypeProject
 	}
 
 	return models.ImportTypeVendor
 }
+
+func (c cachedFile) actual(ctx *resolveContext, info os.FileInfo) bool {
+	return c.modules == ctx.modulesKey &&
+		c.size == info.Size() &&
+		c.modTime.Equal(info.ModTime())
+}
+
+// modulesKey is changed, when project modules or its requires is changed,
+// because imports of cached files should be resolved again
+func modulesKey(modules common.Modules) string {
+	names := make([]string, 0, len(modules))
+	for _, module := range modules {
+		names = append(names, module.Name)
+
+		for _, require := range module.Requires {
+			names = append(names, require.Path+"@"+require.Version)
+		}
+	}
+
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,80 @@
+package scanner
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_fileBuildTags(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []string
+	}{
+		{
+			name:   "no constraints",
+			source: "package a\n",
+			want:   []string{},
+		},
+		{
+			name:   "single tag",
+			source: "//go:build integration\n\npackage a\n",
+			want:   []string{"integration"},
+		},
+		{
+			name:   "negated tags is skipped",
+			source: "//go:build (integration || e2e) && !race\n\npackage a\n",
+			want:   []string{"integration", "e2e"},
+		},
+		{
+			name:   "double negation",
+			source: "//go:build !(!integration)\n\npackage a\n",
+			want:   []string{"integration"},
+		},
+		{
+			name:   "legacy plus build",
+			source: "// +build linux,integration\n\npackage a\n",
+			want:   []string{"linux", "integration"},
+		},
+		{
+			name:   "comments after package clause is ignored",
+			source: "package a\n\n//go:build integration\n",
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fileAst, err := parser.ParseFile(token.NewFileSet(), "a.go", tt.source, parser.ParseComments)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, fileBuildTags(fileAst))
+		})
+	}
+}
+
+func TestScanner_ScanCanceled(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0o600))
+
+	scanner := &Scanner{
+		stdPackages: map[string]struct{}{},
+		cache:       map[string]cachedFile{},
+	}
+
+	files, err := scanner.Scan(context.Background(), dir, nil, nil, nil, models.BuildContext{})
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = scanner.Scan(ctx, dir, nil, nil, nil, models.BuildContext{})
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,146 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+type (
+	// Watcher will poll project tree for changes in go files.
+	// Polling is slower than fs events, but works same
+	// on all platforms, docker volumes and network fs
+	Watcher struct{}
+
+	snapshot map[string]fileState
+
+	fileState struct {
+		modTime time.Time
+		size    int64
+	}
+)
+
+func NewWatcher() *Watcher {
+	return &Watcher{}
+}
+
+// Watch will call onChange with list of changed (created, modified, deleted)
+// files, every time when any *.go file in directory or any of additional
+// files is changed. Additional files is requested before every poll, so
+// list can be changed in onChange. Blocked until ctx is done or onChange return error
+func (w *Watcher) Watch(
+	ctx context.Context,
+	directory string,
+	files func() []string,
+	interval time.Duration,
+	onChange func(changed []string) error,
+) error {
+	prev, err := w.snapshot(directory, files())
+	if err != nil {
+		return fmt.Errorf("failed to snapshot project files: %w", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		next, err := w.snapshot(directory, files())
+		if err != nil {
+			return fmt.Errorf("failed to snapshot project files: %w", err)
+		}
+
+		changed := diff(prev, next)
+		prev = next
+
+		if len(changed) == 0 {
+			continue
+		}
+
+		err = onChange(changed)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (w *Watcher) snapshot(directory string, files []string) (snapshot, error) {
+	state := make(snapshot)
+
+	err := filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				// deleted in the middle of walk
+				return nil
+			}
+
+			return err
+		}
+
+		if info.IsDir() {
+			if path != directory && strings.HasPrefix(info.Name(), ".") {
+				// .git, .idea, etc..
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if filepath.Ext(path) == ".go" {
+			state[path] = newFileState(info)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk '%s': %w", directory, err)
+	}
+
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			// not exist files will be reported as deleted
+			continue
+		}
+
+		state[file] = newFileState(info)
+	}
+
+	return state, nil
+}
+
+func newFileState(info os.FileInfo) fileState {
+	return fileState{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	}
+}
+
+func diff(prev, next snapshot) []string {
+	changed := make([]string, 0)
+
+	for path, nextState := range next {
+		prevState, exist := prev[path]
+		if !exist || prevState.size != nextState.size || !prevState.modTime.Equal(nextState.modTime) {
+			changed = append(changed, path)
+		}
+	}
+
+	for path := range prev {
+		if _, exist := next[path]; !exist {
+			changed = append(changed, path)
+		}
+	}
+
+	sort.Strings(changed)
+	return changed
+}
@@ -0,0 +1,44 @@
+package watcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_diff(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Second)
+
+	tests := []struct {
+		name string
+		prev snapshot
+		next snapshot
+		want []string
+	}{
+		{
+			name: "nothing changed",
+			prev: snapshot{"/a.go": {modTime: now, size: 10}},
+			next: snapshot{"/a.go": {modTime: now, size: 10}},
+			want: []string{},
+		},
+		{
+			name: "modified",
+			prev: snapshot{"/a.go": {modTime: now, size: 10}, "/b.go": {modTime: now, size: 10}},
+			next: snapshot{"/a.go": {modTime: later, size: 10}, "/b.go": {modTime: now, size: 12}},
+			want: []string{"/a.go", "/b.go"},
+		},
+		{
+			name: "created and deleted",
+			prev: snapshot{"/a.go": {modTime: now, size: 10}},
+			next: snapshot{"/c.go": {modTime: now, size: 10}},
+			want: []string{"/a.go", "/c.go"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, diff(tt.prev, tt.next))
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"path"
 	"strconv"
 	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
 )
 
 func (r *Renderer) asciiColorize(color string, value interface{}) (string, error) {
@@ -106,3 +108,16 @@ func (r *Renderer) asciiConcat(sources ...interface{}) string {
 
 	return result
 }
+
+// asciiSeverity returns label for not default severity, errors
+// is printed without label (as before severity levels)
+func (r *Renderer) asciiSeverity(severity models.Severity) (string, error) {
+	switch severity {
+	case models.SeverityWarning:
+		return r.asciiColorize("yellow", "[warning] ")
+	case models.SeverityInfo:
+		return r.asciiColorize("cyan", "[info] ")
+	default:
+		return "", nil
+	}
+}
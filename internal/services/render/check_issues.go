@@ -0,0 +1,195 @@
+package render
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+// check issues is flat list of all check findings,
+// used by machine-readable report formats (sarif, junit, checkstyle)
+
+const (
+	ruleDependency = "arch-deps"
+	ruleMatch      = "arch-not-matched"
+	ruleDeepScan   = "arch-deepscan"
+	ruleCycle      = "arch-cycles"
+	ruleNotice     = "arch-config"
+	ruleUnused     = "arch-unused-suppression"
+	ruleExpired    = "arch-expired-exception"
+	ruleStd        = "arch-std"
+	ruleSymbol     = "arch-symbols"
+	ruleExports    = "arch-exports"
+)
+
+type checkIssue struct {
+	ruleID    string
+	severity  models.Severity
+	component string
+	text      string
+	ref       common.Reference
+}
+
+func assertCheckModel(outputType models.OutputType, model interface{}) (models.CmdCheckOut, error) {
+	checkModel, ok := model.(models.CmdCheckOut)
+	if !ok {
+		return models.CmdCheckOut{}, fmt.Errorf("output type '%s' supported only for check command, got model '%T'",
+			outputType,
+			model,
+		)
+	}
+
+	return checkModel, nil
+}
+
+func checkIssues(model models.CmdCheckOut) []checkIssue {
+	issues := make([]checkIssue, 0)
+
+	for _, notice := range model.DocumentNotices {
+		issues = append(issues, checkIssue{
+			ruleID: ruleNotice,
+			text:   notice.Text,
+			ref:    common.NewReferenceSingleLine(notice.File, notice.Line, notice.Column),
+		})
+	}
+
+	for _, warn := range model.ArchWarningsDependency {
+		ruleID := ruleDependency
+		text := fmt.Sprintf("Component %s shouldn't depend on %s", warn.ComponentName, warn.Target())
+		if warn.NotExportedBy != "" {
+			ruleID = ruleExports
+			text = fmt.Sprintf("%s (%s)", text, warn.Reason())
+		}
+
+		issues = append(issues, checkIssue{
+			ruleID:    ruleID,
+			severity:  warn.Severity,
+			component: warn.ComponentName,
+			text:      text,
+			ref:       warn.Reference,
+		})
+	}
+
+	for _, warn := range model.ArchWarningsMatch {
+		issues = append(issues, checkIssue{
+			ruleID:   ruleMatch,
+			severity: warn.Severity,
+			text:     fmt.Sprintf("File %s not attached to any component in archfile", warn.FileRelativePath),
+			ref:      common.NewReferenceSingleLine(warn.FileAbsolutePath, 0, 0),
+		})
+	}
+
+	for _, warn := range model.ArchWarningsDeepScan {
+		issues = append(issues, checkIssue{
+			ruleID:    ruleDeepScan,
+			severity:  warn.Severity,
+			component: warn.Gate.ComponentName,
+			text: fmt.Sprintf("Dependency %s -> %s not allowed: %s injected into %s",
+				warn.Dependency.ComponentName,
+				warn.Gate.ComponentName,
+				warn.Dependency.Name,
+				warn.Gate.MethodName,
+			),
+			ref: warn.Dependency.Injection,
+		})
+	}
+
+	for _, warn := range model.ArchWarningsCycles {
+		chain := make([]string, 0, len(warn.Chain))
+		for _, step := range warn.Chain {
+			chain = append(chain, fmt.Sprintf("%s -> %s (%s)", step.ComponentName, step.DependOn, step.ResolvedImportName))
+		}
+
+		component := warn.ComponentNames[0]
+		ref := common.NewEmptyReference()
+		if len(warn.Chain) > 0 {
+			// last step close the cycle
+			component = warn.Chain[len(warn.Chain)-1].ComponentName
+			ref = warn.Chain[len(warn.Chain)-1].Reference
+		}
+
+		issues = append(issues, checkIssue{
+			ruleID:    ruleCycle,
+			severity:  warn.Severity,
+			component: component,
+			text: fmt.Sprintf("Cycle between components %s: %s",
+				strings.Join(warn.ComponentNames, ", "),
+				strings.Join(chain, ", "),
+			),
+			ref: ref,
+		})
+	}
+
+	for _, warn := range model.ArchWarningsExpired {
+		issues = append(issues, checkIssue{
+			ruleID:    ruleExpired,
+			severity:  warn.Severity,
+			component: warn.ComponentName,
+			text: fmt.Sprintf("Component %s shouldn't depend on %s, exception expired at %s",
+				warn.ComponentName,
+				warn.ResolvedImportName,
+				warn.Expires,
+			),
+			ref: warn.Reference,
+		})
+	}
+
+	for _, warn := range model.ArchWarningsStd {
+		issues = append(issues, checkIssue{
+			ruleID:    ruleStd,
+			severity:  warn.Severity,
+			component: warn.ComponentName,
+			text:      fmt.Sprintf("Component %s shouldn't use std package %s", warn.ComponentName, warn.ResolvedImportName),
+			ref:       warn.Reference,
+		})
+	}
+
+	for _, warn := range model.ArchWarningsSymbols {
+		issues = append(issues, checkIssue{
+			ruleID:    ruleSymbol,
+			severity:  warn.Severity,
+			component: warn.ComponentName,
+			text:      fmt.Sprintf("Component %s shouldn't use %s (%s)", warn.ComponentName, warn.Symbol, warn.ResolvedImportName),
+			ref:       warn.Reference,
+		})
+	}
+
+	for _, suppression := range model.UnusedSuppressions {
+		issues = append(issues, checkIssue{
+			ruleID:    ruleUnused,
+			component: suppression.ComponentName,
+			text:      fmt.Sprintf("Unused suppression comment '%s'", suppression.Reason),
+			ref:       suppression.Reference,
+		})
+	}
+
+	return issues
+}
+
+// issueSeverity returns known severity level, config
+// notices and unused suppressions is always errors
+func (issue checkIssue) issueSeverity() models.Severity {
+	if !models.IsKnownSeverity(issue.severity) {
+		return models.SeverityError
+	}
+
+	return issue.severity
+}
+
+// relativeFilePath returns path relative to project directory (in slash format),
+// or false, when file is outside of project
+func relativeFilePath(file string, projectDirectory string) (string, bool) {
+	if projectDirectory == "" {
+		return filepath.ToSlash(file), false
+	}
+
+	relPath, err := filepath.Rel(projectDirectory, file)
+	if err != nil || strings.HasPrefix(relPath, "..") {
+		return filepath.ToSlash(file), false
+	}
+
+	return filepath.ToSlash(relPath), true
+}
@@ -0,0 +1,80 @@
+package render
+
+import (
+	"encoding/xml"
+	"sort"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+const (
+	checkstyleVersion      = "4.3"
+	checkstyleSourcePrefix = "go-arch-lint."
+)
+
+type (
+	checkstyleReport struct {
+		XMLName xml.Name         `xml:"checkstyle"`
+		Version string           `xml:"version,attr"`
+		Files   []checkstyleFile `xml:"file"`
+	}
+
+	checkstyleFile struct {
+		Name   string            `xml:"name,attr"`
+		Errors []checkstyleError `xml:"error"`
+	}
+
+	checkstyleError struct {
+		Line     int    `xml:"line,attr,omitempty"`
+		Column   int    `xml:"column,attr,omitempty"`
+		Severity string `xml:"severity,attr"`
+		Message  string `xml:"message,attr"`
+		Source   string `xml:"source,attr"`
+	}
+)
+
+// renderCheckstyle output check results as Checkstyle XML report,
+// warnings are grouped by file
+func (r *Renderer) renderCheckstyle(model interface{}) error {
+	checkModel, err := assertCheckModel(models.OutputTypeCheckstyle, model)
+	if err != nil {
+		return err
+	}
+
+	files := make(map[string]*checkstyleFile)
+	fileNames := make([]string, 0)
+
+	for _, issue := range checkIssues(checkModel) {
+		if !issue.ref.Valid {
+			// checkstyle format not support errors without file
+			continue
+		}
+
+		fileName, _ := relativeFilePath(issue.ref.File, checkModel.ProjectDirectory)
+		if _, exist := files[fileName]; !exist {
+			files[fileName] = &checkstyleFile{Name: fileName}
+			fileNames = append(fileNames, fileName)
+		}
+
+		files[fileName].Errors = append(files[fileName].Errors, checkstyleError{
+			Line:     issue.ref.Line,
+			Column:   issue.ref.Column,
+			Severity: issue.issueSeverity(), // checkstyle use same levels: error, warning, info
+			Message:  issue.text,
+			Source:   checkstyleSourcePrefix + issue.ruleID,
+		})
+	}
+
+	sort.Strings(fileNames)
+
+	report := checkstyleReport{
+		Version: checkstyleVersion,
+		Files:   make([]checkstyleFile, 0, len(fileNames)),
+	}
+
+	for _, fileName := range fileNames {
+		report.Files = append(report.Files, *files[fileName])
+	}
+
+	return r.printXML(report)
+}
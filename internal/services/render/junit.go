@@ -0,0 +1,143 @@
+package render
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+const (
+	junitSuiteNotAttached = "[not attached]"
+	junitSuiteArchFile    = "[archfile]"
+	junitCasePassed       = "imports"
+)
+
+type (
+	junitTestSuites struct {
+		XMLName  xml.Name         `xml:"testsuites"`
+		Name     string           `xml:"name,attr"`
+		Tests    int              `xml:"tests,attr"`
+		Failures int              `xml:"failures,attr"`
+		Suites   []junitTestSuite `xml:"testsuite"`
+	}
+
+	junitTestSuite struct {
+		Name     string          `xml:"name,attr"`
+		Tests    int             `xml:"tests,attr"`
+		Failures int             `xml:"failures,attr"`
+		Cases    []junitTestCase `xml:"testcase"`
+	}
+
+	junitTestCase struct {
+		Name      string        `xml:"name,attr"`
+		ClassName string        `xml:"classname,attr"`
+		File      string        `xml:"file,attr,omitempty"`
+		Line      int           `xml:"line,attr,omitempty"`
+		Failure   *junitFailure `xml:"failure,omitempty"`
+	}
+
+	junitFailure struct {
+		Message string `xml:"message,attr"`
+		Type    string `xml:"type,attr"`
+		Text    string `xml:",chardata"`
+	}
+)
+
+// renderJUnit output check results as JUnit XML report.
+// Every component is test suite, and every warning is failed test case in it.
+// Components without warnings will have one passed test case.
+func (r *Renderer) renderJUnit(model interface{}) error {
+	checkModel, err := assertCheckModel(models.OutputTypeJUnit, model)
+	if err != nil {
+		return err
+	}
+
+	suites := make(map[string]*junitTestSuite)
+	suiteNames := make([]string, 0)
+	suite := func(name string) *junitTestSuite {
+		if _, exist := suites[name]; !exist {
+			suites[name] = &junitTestSuite{Name: name, Cases: []junitTestCase{}}
+			suiteNames = append(suiteNames, name)
+		}
+
+		return suites[name]
+	}
+
+	for _, componentName := range checkModel.ComponentNames {
+		suite(componentName)
+	}
+
+	for _, issue := range checkIssues(checkModel) {
+		suiteName := issue.component
+		switch issue.ruleID {
+		case ruleNotice:
+			suiteName = junitSuiteArchFile
+		case ruleMatch:
+			suiteName = junitSuiteNotAttached
+		}
+
+		relPath, _ := relativeFilePath(issue.ref.File, checkModel.ProjectDirectory)
+		testCase := junitTestCase{
+			Name:      issue.text,
+			ClassName: suiteName,
+			Failure: &junitFailure{
+				Message: issue.text,
+				Type:    issue.ruleID,
+			},
+		}
+
+		if issue.ref.Valid {
+			testCase.File = relPath
+			testCase.Failure.Text = relPath
+
+			if issue.ref.Line > 0 {
+				testCase.Line = issue.ref.Line
+				testCase.Failure.Text = fmt.Sprintf("%s:%d", relPath, issue.ref.Line)
+
+				if issue.ref.Column > 0 {
+					testCase.Failure.Text = fmt.Sprintf("%s:%d:%d", relPath, issue.ref.Line, issue.ref.Column)
+				}
+			}
+		}
+
+		s := suite(suiteName)
+		s.Cases = append(s.Cases, testCase)
+		s.Failures++
+	}
+
+	sort.Strings(suiteNames)
+
+	report := junitTestSuites{
+		Name:   "go-arch-lint",
+		Suites: make([]junitTestSuite, 0, len(suiteNames)),
+	}
+
+	for _, name := range suiteNames {
+		s := suites[name]
+		if len(s.Cases) == 0 {
+			s.Cases = append(s.Cases, junitTestCase{
+				Name:      junitCasePassed,
+				ClassName: name,
+			})
+		}
+
+		s.Tests = len(s.Cases)
+		report.Tests += s.Tests
+		report.Failures += s.Failures
+		report.Suites = append(report.Suites, *s)
+	}
+
+	return r.printXML(report)
+}
+
+func (r *Renderer) printXML(report any) error {
+	buffer, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal xml report: %w", err)
+	}
+
+	fmt.Println(xml.Header + string(buffer))
+	return nil
+}
@@ -23,6 +23,7 @@ const (
 	fnPlus       = "plus"
 	fnMinus      = "minus"
 	fnConcat     = "concat"
+	fnSeverity   = "severity"
 )
 
 type (
@@ -32,6 +33,7 @@ type (
 		outputType        models.OutputType
 		outputJSONOneLine bool
 		asciiTemplates    map[string]string
+		toolVersion       string
 	}
 )
 
@@ -41,6 +43,7 @@ func NewRenderer(
 	outputType models.OutputType,
 	outputJSONOneLine bool,
 	asciiTemplates map[string]string,
+	toolVersion string,
 ) *Renderer {
 	return &Renderer{
 		colorPrinter:      colorPrinter,
@@ -48,6 +51,7 @@ func NewRenderer(
 		outputType:        outputType,
 		outputJSONOneLine: outputJSONOneLine,
 		asciiTemplates:    asciiTemplates,
+		toolVersion:       toolVersion,
 	}
 }
 
@@ -71,6 +75,12 @@ func (r *Renderer) RenderModel(model interface{}, err error) error {
 		renderErr = r.renderJSON(model)
 	case models.OutputTypeASCII:
 		renderErr = r.renderASCII(model)
+	case models.OutputTypeSARIF:
+		renderErr = r.renderSARIF(model)
+	case models.OutputTypeJUnit:
+		renderErr = r.renderJUnit(model)
+	case models.OutputTypeCheckstyle:
+		renderErr = r.renderCheckstyle(model)
 	default:
 		panic(fmt.Sprintf("failed to render: unknown output type: %s", r.outputType))
 	}
@@ -104,6 +114,7 @@ func (r *Renderer) renderASCII(model interface{}) error {
 			fnPlus:       r.asciiPlus,
 			fnMinus:      r.asciiMinus,
 			fnConcat:     r.asciiConcat,
+			fnSeverity:   r.asciiSeverity,
 		}).
 		Parse(
 			preprocessRawASCIITemplate(templateBuffer),
@@ -0,0 +1,265 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+// SARIF 2.1.0 (Static Analysis Results Interchange Format)
+// spec: https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+const (
+	sarifVersion   = "2.1.0"
+	sarifSchema    = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifToolName  = "go-arch-lint"
+	sarifToolURI   = "https://github.com/fe3dback/go-arch-lint"
+	sarifSrcRootID = "%SRCROOT%"
+	sarifLevelErr  = "error"
+	sarifLevelWarn = "warning"
+	sarifLevelNote = "note"
+)
+
+type (
+	sarifLog struct {
+		Version string     `json:"version"`
+		Schema  string     `json:"$schema"`
+		Runs    []sarifRun `json:"runs"`
+	}
+
+	sarifRun struct {
+		Tool               sarifTool                    `json:"tool"`
+		OriginalURIBaseIDs map[string]sarifArtifactPath `json:"originalUriBaseIds,omitempty"`
+		Results            []sarifResult                `json:"results"`
+	}
+
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+
+	sarifDriver struct {
+		Name           string      `json:"name"`
+		InformationURI string      `json:"informationUri"`
+		Version        string      `json:"version,omitempty"`
+		Rules          []sarifRule `json:"rules"`
+	}
+
+	sarifRule struct {
+		ID               string       `json:"id"`
+		Name             string       `json:"name"`
+		ShortDescription sarifMessage `json:"shortDescription"`
+		HelpURI          string       `json:"helpUri"`
+	}
+
+	sarifResult struct {
+		RuleID    string          `json:"ruleId"`
+		RuleIndex int             `json:"ruleIndex"`
+		Level     string          `json:"level"`
+		Message   sarifMessage    `json:"message"`
+		Locations []sarifLocation `json:"locations,omitempty"`
+	}
+
+	sarifMessage struct {
+		Text string `json:"text"`
+	}
+
+	sarifLocation struct {
+		PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	}
+
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactPath `json:"artifactLocation"`
+		Region           *sarifRegion      `json:"region,omitempty"`
+	}
+
+	sarifArtifactPath struct {
+		URI       string `json:"uri"`
+		URIBaseID string `json:"uriBaseId,omitempty"`
+	}
+
+	sarifRegion struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn,omitempty"`
+	}
+)
+
+var sarifRules = []sarifRule{
+	{
+		ID:               ruleDependency,
+		Name:             "ComponentDependency",
+		ShortDescription: sarifMessage{Text: "component imports package not allowed by arch file deps rules"},
+		HelpURI:          sarifToolURI + "/blob/master/docs/syntax/README.md",
+	},
+	{
+		ID:               ruleMatch,
+		Name:             "FileNotMatched",
+		ShortDescription: sarifMessage{Text: "file not attached to any component in arch file"},
+		HelpURI:          sarifToolURI + "/blob/master/docs/syntax/README.md",
+	},
+	{
+		ID:               ruleDeepScan,
+		Name:             "DeepScanInjection",
+		ShortDescription: sarifMessage{Text: "component injected into method of component that not allowed to depend on it"},
+		HelpURI:          sarifToolURI + "/blob/master/docs/syntax/README.md",
+	},
+	{
+		ID:               ruleNotice,
+		Name:             "ArchFileNotice",
+		ShortDescription: sarifMessage{Text: "arch file is invalid"},
+		HelpURI:          sarifToolURI + "/blob/master/docs/syntax/README.md",
+	},
+	{
+		ID:               ruleCycle,
+		Name:             "ComponentsCycle",
+		ShortDescription: sarifMessage{Text: "components depend on each other (directly or through other components)"},
+		HelpURI:          sarifToolURI + "/blob/master/docs/syntax/README.md",
+	},
+	{
+		ID:               ruleUnused,
+		Name:             "UnusedSuppression",
+		ShortDescription: sarifMessage{Text: "suppression comment not suppress any warning"},
+		HelpURI:          sarifToolURI + "/blob/master/README.md",
+	},
+	{
+		ID:               ruleExpired,
+		Name:             "ExpiredException",
+		ShortDescription: sarifMessage{Text: "component imports package allowed by already expired exception in arch file"},
+		HelpURI:          sarifToolURI + "/blob/master/docs/syntax/README.md",
+	},
+	{
+		ID:               ruleStd,
+		Name:             "StdImport",
+		ShortDescription: sarifMessage{Text: "component imports std package that not allowed in arch file"},
+		HelpURI:          sarifToolURI + "/blob/master/docs/syntax/README.md",
+	},
+	{
+		ID:               ruleSymbol,
+		Name:             "SymbolUsage",
+		ShortDescription: sarifMessage{Text: "component uses identifier of imported package that not allowed by symbols rule in arch file"},
+		HelpURI:          sarifToolURI + "/blob/master/docs/syntax/README.md",
+	},
+	{
+		ID:               ruleExports,
+		Name:             "NotExportedImport",
+		ShortDescription: sarifMessage{Text: "component imports package of other component that not listed in its exports"},
+		HelpURI:          sarifToolURI + "/blob/master/docs/syntax/README.md",
+	},
+}
+
+func (r *Renderer) renderSARIF(model interface{}) error {
+	checkModel, err := assertCheckModel(models.OutputTypeSARIF, model)
+	if err != nil {
+		return err
+	}
+
+	log := sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           sarifToolName,
+						InformationURI: sarifToolURI,
+						Version:        r.toolVersion,
+						Rules:          sarifRules,
+					},
+				},
+				OriginalURIBaseIDs: map[string]sarifArtifactPath{
+					sarifSrcRootID: {URI: fmt.Sprintf("file://%s/", filepath.ToSlash(checkModel.ProjectDirectory))},
+				},
+				Results: r.sarifResults(checkModel),
+			},
+		},
+	}
+
+	var buffer []byte
+
+	if r.outputJSONOneLine {
+		buffer, err = json.Marshal(log)
+	} else {
+		buffer, err = json.MarshalIndent(log, "", "  ")
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to marshal sarif log: %w", err)
+	}
+
+	fmt.Println(string(buffer))
+	return nil
+}
+
+func (r *Renderer) sarifResults(model models.CmdCheckOut) []sarifResult {
+	results := make([]sarifResult, 0)
+
+	for _, issue := range checkIssues(model) {
+		results = append(results, r.sarifResult(issue, model.ProjectDirectory))
+	}
+
+	return results
+}
+
+func (r *Renderer) sarifResult(issue checkIssue, projectDirectory string) sarifResult {
+	ref := issue.ref
+	result := sarifResult{
+		RuleID:    issue.ruleID,
+		RuleIndex: sarifRuleIndex(issue.ruleID),
+		Level:     sarifLevel(issue.issueSeverity()),
+		Message:   sarifMessage{Text: issue.text},
+	}
+
+	if !ref.Valid {
+		return result
+	}
+
+	location := sarifPhysicalLocation{
+		ArtifactLocation: sarifArtifactLocation(ref.File, projectDirectory),
+	}
+
+	if ref.Line > 0 {
+		location.Region = &sarifRegion{
+			StartLine:   ref.Line,
+			StartColumn: ref.Column,
+		}
+	}
+
+	result.Locations = []sarifLocation{{PhysicalLocation: location}}
+	return result
+}
+
+func sarifRuleIndex(ruleID string) int {
+	for ind, rule := range sarifRules {
+		if rule.ID == ruleID {
+			return ind
+		}
+	}
+
+	panic(fmt.Sprintf("unknown sarif rule: %s", ruleID))
+}
+
+// code scanning tools expect paths relative to repository root,
+// so all project files will be relative to %SRCROOT%
+func sarifArtifactLocation(file string, projectDirectory string) sarifArtifactPath {
+	relPath, inProject := relativeFilePath(file, projectDirectory)
+	if !inProject {
+		return sarifArtifactPath{URI: fmt.Sprintf("file://%s", relPath)}
+	}
+
+	return sarifArtifactPath{
+		URI:       relPath,
+		URIBaseID: sarifSrcRootID,
+	}
+}
+
+func sarifLevel(severity models.Severity) string {
+	switch severity {
+	case models.SeverityWarning:
+		return sarifLevelWarn
+	case models.SeverityInfo:
+		return sarifLevelNote
+	default:
+		return sarifLevelErr
+	}
+}
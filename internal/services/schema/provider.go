@@ -14,6 +14,9 @@ var v2 []byte
 //go:embed v3.json
 var v3 []byte
 
+//go:embed v4.json
+var v4 []byte
+
 type Provider struct {
 }
 
@@ -23,6 +26,8 @@ func NewProvider() *Provider {
 
 func (p *Provider) Provide(version int) ([]byte, error) {
 	switch version {
+	case 4:
+		return v4, nil
 	case 3:
 		return v3, nil
 	case 2:
@@ -27,15 +27,30 @@ func (aia *allowedProjectImportsAssembler) assemble(
 	yamlDocument spec.Document,
 	componentNames []string,
 ) ([]models.ResolvedPath, error) {
-	list := make([]models.ResolvedPath, 0)
-
 	allowedComponents := make([]string, 0)
 	allowedComponents = append(allowedComponents, componentNames...)
 	for _, componentName := range yamlDocument.CommonComponents() {
 		allowedComponents = append(allowedComponents, componentName.Value)
 	}
 
-	for _, name := range allowedComponents {
+	return aia.resolveComponents(yamlDocument, allowedComponents)
+}
+
+// assembleListed resolve only listed components, without common components
+func (aia *allowedProjectImportsAssembler) assembleListed(
+	yamlDocument spec.Document,
+	componentNames []string,
+) ([]models.ResolvedPath, error) {
+	return aia.resolveComponents(yamlDocument, componentNames)
+}
+
+func (aia *allowedProjectImportsAssembler) resolveComponents(
+	yamlDocument spec.Document,
+	componentNames []string,
+) ([]models.ResolvedPath, error) {
+	list := make([]models.ResolvedPath, 0)
+
+	for _, name := range componentNames {
 		yamlComponent, ok := yamlDocument.Components()[name]
 		if !ok {
 			continue
@@ -21,25 +21,53 @@ func newAllowedVendorImportsAssembler(
 func (aia *allowedVendorImportsAssembler) assemble(
 	yamlDocument spec.Document,
 	vendorNames []string,
-) ([]common.Referable[models.Glob], error) {
-	list := make([]common.Referable[models.Glob], 0)
-
+) ([]common.Referable[models.VendorGlob], error) {
 	allowedVendors := make([]string, 0)
 	allowedVendors = append(allowedVendors, vendorNames...)
 	for _, vendorName := range yamlDocument.CommonVendors() {
 		allowedVendors = append(allowedVendors, vendorName.Value)
 	}
 
-	for _, name := range allowedVendors {
+	return aia.resolveVendors(yamlDocument, allowedVendors), nil
+}
+
+// assembleListed resolve only listed vendors, without common vendors
+func (aia *allowedVendorImportsAssembler) assembleListed(
+	yamlDocument spec.Document,
+	vendorNames []string,
+) ([]common.Referable[models.VendorGlob], error) {
+	return aia.resolveVendors(yamlDocument, vendorNames), nil
+}
+
+func (aia *allowedVendorImportsAssembler) resolveVendors(
+	yamlDocument spec.Document,
+	vendorNames []string,
+) []common.Referable[models.VendorGlob] {
+	list := make([]common.Referable[models.VendorGlob], 0)
+
+	for _, name := range vendorNames {
 		yamlVendor, ok := yamlDocument.Vendors()[name]
 		if !ok {
 			continue
 		}
 
-		for _, vendorIn := range yamlVendor.Value.ImportPaths() {
-			list = append(list, common.NewReferable(vendorIn, yamlVendor.Reference))
-		}
+		list = append(list, vendorGlobs(yamlVendor)...)
+	}
+
+	return list
+}
+
+// vendorGlobs returns import paths globs and modules globs of vendor
+func vendorGlobs(yamlVendor common.Referable[spec.Vendor]) []common.Referable[models.VendorGlob] {
+	list := make([]common.Referable[models.VendorGlob], 0)
+
+	for _, vendorIn := range yamlVendor.Value.ImportPaths() {
+		list = append(list, common.NewReferable(models.VendorGlob{Glob: vendorIn}, yamlVendor.Reference))
+	}
+
+	for _, module := range yamlVendor.Value.Modules() {
+		list = append(list, common.NewReferable(models.VendorGlob{Glob: module, Module: true}, yamlVendor.Reference))
 	}
 
-	return list, nil
+	return list
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
 	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
 )
 
 type (
@@ -28,26 +29,46 @@ func NewAssembler(
 }
 
 func (sa *Assembler) Assemble(prj common.Project) (arch.Spec, error) {
-	spec := arch.Spec{
+	document, schemeNotices, err := sa.decoder.Decode(prj.GoArchFilePath)
+	if err != nil {
+		return sa.emptySpec(prj), fmt.Errorf("failed to decode document '%s': %w", prj.GoArchFilePath, err)
+	}
+
+	return sa.assemble(prj, document, schemeNotices)
+}
+
+// AssembleSource is same as Assemble, but arch file is not read from
+// disk, prj.GoArchFilePath is used only for references in notices
+func (sa *Assembler) AssembleSource(prj common.Project, sourceCode []byte) (arch.Spec, error) {
+	document, schemeNotices, err := sa.decoder.DecodeSource(prj.GoArchFilePath, sourceCode)
+	if err != nil {
+		return sa.emptySpec(prj), fmt.Errorf("failed to decode document '%s': %w", prj.GoArchFilePath, err)
+	}
+
+	return sa.assemble(prj, document, schemeNotices)
+}
+
+func (sa *Assembler) emptySpec(prj common.Project) arch.Spec {
+	return arch.Spec{
 		RootDirectory: common.NewEmptyReferable(prj.Directory),
 		ModuleName:    common.NewEmptyReferable(prj.ModuleName),
+		Modules:       prj.Modules,
 		Integrity: arch.Integrity{
 			DocumentNotices: []arch.Notice{},
 			Suggestions:     []arch.Notice{},
 		},
 	}
+}
 
-	document, schemeNotices, err := sa.decoder.Decode(prj.GoArchFilePath)
-	if err != nil {
-		return spec, fmt.Errorf("failed to decode document '%s': %w", prj.GoArchFilePath, err)
-	}
+func (sa *Assembler) assemble(prj common.Project, document spec.Document, schemeNotices []arch.Notice) (arch.Spec, error) {
+	spec := sa.emptySpec(prj)
 
 	if len(schemeNotices) > 0 {
 		// only simple scheme validation errors
 		spec.Integrity.DocumentNotices = append(spec.Integrity.DocumentNotices, schemeNotices...)
 	} else {
 		// if scheme is ok, need check arch errors
-		advancedErrors := sa.validator.Validate(document, prj.Directory)
+		advancedErrors := sa.validator.Validate(document, prj.Directory, prj.Modules)
 		spec.Integrity.DocumentNotices = append(spec.Integrity.DocumentNotices, advancedErrors...)
 	}
 
@@ -55,10 +76,12 @@ func (sa *Assembler) Assemble(prj common.Project) (arch.Spec, error) {
 		return spec, nil
 	}
 
+	spec.IncludedFiles = document.IncludedFiles()
+
 	resolver := newResolver(
 		sa.pathResolver,
 		prj.Directory,
-		prj.ModuleName,
+		prj.Modules,
 	)
 
 	assembler := newSpecCompositeAssembler([]assembler{
@@ -72,13 +95,19 @@ func (sa *Assembler) Assemble(prj common.Project) (arch.Spec, error) {
 				resolver,
 			),
 		),
+		newVendorsAssembler(),
 		newExcludeAssembler(resolver),
 		newExcludeFilesMatcherAssembler(),
 		newAllowAssembler(),
+		newLayersAssembler(),
+		newSeverityAssembler(),
+		newBuildAssembler(),
+		newStdAssembler(),
 		newWorkdirAssembler(),
+		newDepsCyclesAssembler(),
 	})
 
-	err = assembler.assemble(&spec, document)
+	err := assembler.assemble(&spec, document)
 	if err != nil {
 		return spec, fmt.Errorf("failed to assemble document: %w", err)
 	}
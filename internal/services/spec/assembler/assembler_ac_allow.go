@@ -16,6 +16,9 @@ func (efa *allowAssembler) assemble(spec *arch.Spec, document spec.Document) err
 	spec.Allow = arch.Allow{
 		DepOnAnyVendor: document.Options().IsDependOnAnyVendor(),
 		DeepScan:       document.Options().DeepScan(),
+		DetectCycles:   document.Options().DetectCycles(),
+		StrictLayers:   document.Options().StrictLayers(),
+		IgnoreTests:    document.Options().IgnoreTests(),
 	}
 
 	return nil
@@ -0,0 +1,44 @@
+package assembler
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type buildAssembler struct {
+}
+
+func newBuildAssembler() *buildAssembler {
+	return &buildAssembler{}
+}
+
+func (ba *buildAssembler) assemble(spec *arch.Spec, document spec.Document) error {
+	build := document.Build()
+	if !build.Defined() {
+		return nil
+	}
+
+	tags := unwrap(build.Tags())
+	tagSets := make([][]string, 0, len(build.TagSets()))
+
+	for _, tagSet := range build.TagSets() {
+		set := make([]string, 0, len(tags)+len(tagSet.Value))
+		set = append(set, tags...)
+		set = append(set, tagSet.Value...)
+
+		tagSets = append(tagSets, set)
+	}
+
+	if len(tagSets) == 0 {
+		tagSets = append(tagSets, tags)
+	}
+
+	spec.Build = arch.Build{
+		Enabled: true,
+		GOOS:    build.GOOS(),
+		GOARCH:  build.GOARCH(),
+		TagSets: tagSets,
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@ package assembler
 import (
 	"fmt"
 	"path"
+	"sort"
+	"time"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
@@ -40,9 +42,50 @@ func (m *componentsAssembler) assemble(spec *arch.Spec, document spec.Document)
 		spec.Components = append(spec.Components, component)
 	}
 
+	hideNotExported(spec.Components)
 	return nil
 }
 
+// hideNotExported will attach not exported packages of components with
+// "exports" list to all other components, so they can't import them
+func hideNotExported(components []arch.Component) {
+	owners := make(map[string]int)
+	for _, cmp := range components {
+		for _, resolvedPath := range cmp.ResolvedPaths {
+			owners[resolvedPath.Value.ImportPath]++
+		}
+	}
+
+	for _, target := range components {
+		if len(target.ExportedPaths) == 0 {
+			continue
+		}
+
+		exported := make(map[string]bool, len(target.ExportedPaths))
+		for _, exportedPath := range target.ExportedPaths {
+			exported[exportedPath.Value.ImportPath] = true
+		}
+
+		for ind := range components {
+			if components[ind].Name.Value == target.Name.Value {
+				continue
+			}
+
+			for _, resolvedPath := range target.ResolvedPaths {
+				// package also matched by another (nested) component
+				if exported[resolvedPath.Value.ImportPath] || owners[resolvedPath.Value.ImportPath] > 1 {
+					continue
+				}
+
+				components[ind].NotExportedImports = append(components[ind].NotExportedImports, arch.NotExportedImport{
+					ComponentName: target.Name.Value,
+					ResolvedPath:  resolvedPath,
+				})
+			}
+		}
+	}
+}
+
 func (m *componentsAssembler) assembleComponent(
 	yamlName string,
 	yamlComponent common.Referable[spec.Component],
@@ -51,28 +94,73 @@ func (m *componentsAssembler) assembleComponent(
 	depMeta, hasDeps := yamlDocument.Dependencies()[yamlName]
 
 	mayDependOn := make([]common.Referable[string], 0)
+	mayNotDependOn := make([]common.Referable[string], 0)
 	canUse := make([]common.Referable[string], 0)
+	cannotUse := make([]common.Referable[string], 0)
+	canUseStd := make([]common.Referable[string], 0)
+	cannotUseStd := make([]common.Referable[string], 0)
+	anyCapture := make([]common.Referable[string], 0)
+	tests := arch.AdditionalRules{}
+	tags := make(map[string]arch.AdditionalRules)
 	deepScan := yamlDocument.Options().DeepScan()
+	severity := severities(yamlDocument.Severity())
+	depsReference := common.NewEmptyReference()
 
 	if hasDeps {
+		depsReference = depMeta.Reference
 		mayDependOn = append(mayDependOn, depMeta.Value.MayDependOn()...)
+		mayNotDependOn = append(mayNotDependOn, depMeta.Value.MayNotDependOn()...)
 		canUse = append(canUse, depMeta.Value.CanUse()...)
+		cannotUse = append(cannotUse, depMeta.Value.CannotUse()...)
+		canUseStd = append(canUseStd, depMeta.Value.CanUseStd()...)
+		cannotUseStd = append(cannotUseStd, depMeta.Value.CannotUseStd()...)
+		anyCapture = append(anyCapture, depMeta.Value.AnyCapture()...)
+		tests = additionalRules(depMeta.Value.Tests())
+		for tag, rule := range depMeta.Value.Tags() {
+			tags[tag] = additionalRules(rule)
+		}
 		deepScan = depMeta.Value.DeepScan()
+		severity = severities(depMeta.Value.Severity(), yamlDocument.Severity())
+	}
+
+	// explicit deps has priority, so layer deps added only when missing
+	for _, layerDep := range layerDependencies(yamlDocument, yamlName) {
+		if !inList(unwrap(mayDependOn), layerDep.Value) {
+			mayDependOn = append(mayDependOn, layerDep)
+		}
 	}
 
 	cmp := arch.Component{
-		Name:        common.NewReferable(yamlName, yamlComponent.Reference),
-		MayDependOn: mayDependOn,
-		CanUse:      canUse,
-		DeepScan:    deepScan,
+		Name:            common.NewReferable(yamlName, yamlComponent.Reference),
+		DepsReference:   depsReference,
+		MayDependOn:     mayDependOn,
+		MayNotDependOn:  mayNotDependOn,
+		CanUse:          canUse,
+		CannotUse:       cannotUse,
+		AllowedStdGlobs: globs(canUseStd),
+		DeniedStdGlobs:  globs(cannotUseStd),
+		AnyCapture:      anyCapture,
+		DeepScan:        deepScan,
+		Severity:        severity,
+		Tests:           tests,
+		Tags:            tags,
 	}
 
 	type enricher func() error
 	enrichers := []enricher{
 		func() error { return m.enrichWithFlags(&cmp, yamlComponent, hasDeps, depMeta.Value) },
 		func() error { return m.enrichWithResolvedPaths(&cmp, yamlDocument, yamlName, yamlComponent) },
+		func() error { return m.enrichWithExports(&cmp, yamlDocument, yamlName, yamlComponent) },
 		func() error { return m.enrichWithProjectImports(&cmp, yamlComponent, yamlDocument, mayDependOn) },
 		func() error { return m.enrichWithVendorGlobs(&cmp, yamlDocument, canUse) },
+		func() error {
+			return m.enrichWithDeniedProjectImports(&cmp, yamlComponent, yamlDocument, mayNotDependOn)
+		},
+		func() error { return m.enrichWithDeniedVendorGlobs(&cmp, yamlDocument, cannotUse) },
+		func() error { return m.enrichWithAnyCaptureImports(&cmp, yamlComponent, yamlDocument, anyCapture) },
+		func() error { return m.enrichWithExceptions(&cmp, yamlDocument, yamlName) },
+		func() error { return m.enrichWithAdditionalRules(&cmp, yamlComponent, yamlDocument) },
+		func() error { return m.enrichWithSymbolRules(&cmp, yamlDocument, yamlName) },
 	}
 
 	for _, enrich := range enrichers {
@@ -99,6 +187,7 @@ func (m *componentsAssembler) enrichWithFlags(
 		cmp.SpecialFlags = arch.SpecialFlags{
 			AllowAllProjectDeps: depMeta.AnyProjectDeps(),
 			AllowAllVendorDeps:  depMeta.AnyVendorDeps(),
+			SameCapture:         depMeta.SameCapture(),
 		}
 		return nil
 	}
@@ -106,6 +195,7 @@ func (m *componentsAssembler) enrichWithFlags(
 	cmp.SpecialFlags = arch.SpecialFlags{
 		AllowAllProjectDeps: common.NewReferable(false, yamlComponent.Reference),
 		AllowAllVendorDeps:  common.NewReferable(false, yamlComponent.Reference),
+		SameCapture:         common.NewReferable(false, yamlComponent.Reference),
 	}
 
 	return nil
@@ -117,29 +207,53 @@ func (m *componentsAssembler) enrichWithResolvedPaths(
 	yamlName string,
 	yamlComponent common.Referable[spec.Component],
 ) error {
+	resolvedPaths, err := m.resolvePaths(yamlDocument, yamlComponent, yamlComponent.Value.RelativePaths())
+	if err != nil {
+		return fmt.Errorf("failed to assemble component '%s' path: %w", yamlName, err)
+	}
+
+	cmp.ResolvedPaths = resolvedPaths
+	return nil
+}
+
+func (m *componentsAssembler) enrichWithExports(
+	cmp *arch.Component,
+	yamlDocument spec.Document,
+	yamlName string,
+	yamlComponent common.Referable[spec.Component],
+) error {
+	exportedPaths, err := m.resolvePaths(yamlDocument, yamlComponent, yamlComponent.Value.Exports())
+	if err != nil {
+		return fmt.Errorf("failed to assemble component '%s' exports: %w", yamlName, err)
+	}
+
+	cmp.ExportedPaths = exportedPaths
+	return nil
+}
+
+func (m *componentsAssembler) resolvePaths(
+	yamlDocument spec.Document,
+	yamlComponent common.Referable[spec.Component],
+	globs []models.Glob,
+) ([]common.Referable[models.ResolvedPath], error) {
 	resolvedPaths := make([]common.Referable[models.ResolvedPath], 0)
 
-	for _, componentIn := range yamlComponent.Value.RelativePaths() {
+	for _, glob := range globs {
 		tmpResolvedPath, err := m.resolver.resolveLocalGlobPath(
 			path.Clean(fmt.Sprintf("%s/%s",
 				yamlDocument.WorkingDirectory().Value,
-				string(componentIn),
+				string(glob),
 			)),
 		)
 		if err != nil {
-			return fmt.Errorf("failed to assemble component '%s' path '%s': %w",
-				yamlName,
-				componentIn,
-				err,
-			)
+			return nil, fmt.Errorf("failed to resolve '%s': %w", glob, err)
 		}
 
 		wrappedPaths := wrap(yamlComponent.Reference, tmpResolvedPath)
 		resolvedPaths = append(resolvedPaths, wrappedPaths...)
 	}
 
-	cmp.ResolvedPaths = resolvedPaths
-	return nil
+	return resolvedPaths, nil
 }
 
 func (m *componentsAssembler) enrichWithProjectImports(
@@ -170,3 +284,186 @@ func (m *componentsAssembler) enrichWithVendorGlobs(
 	cmp.AllowedVendorGlobs = vendorGlobs
 	return nil
 }
+
+func (m *componentsAssembler) enrichWithDeniedProjectImports(
+	cmp *arch.Component,
+	yamlComponent common.Referable[spec.Component],
+	yamlDocument spec.Document,
+	mayNotDependOn []common.Referable[string],
+) error {
+	projectImports, err := m.allowedProjectImportsAssembler.assembleListed(yamlDocument, unwrap(mayNotDependOn))
+	if err != nil {
+		return fmt.Errorf("failed to assemble component denied project imports: %w", err)
+	}
+
+	cmp.DeniedProjectImports = wrap(yamlComponent.Reference, projectImports)
+	return nil
+}
+
+func (m *componentsAssembler) enrichWithDeniedVendorGlobs(
+	cmp *arch.Component,
+	yamlDocument spec.Document,
+	cannotUse []common.Referable[string],
+) error {
+	vendorGlobs, err := m.allowedVendorImportsAssembler.assembleListed(yamlDocument, unwrap(cannotUse))
+	if err != nil {
+		return fmt.Errorf("failed to assemble component denied vendor imports: %w", err)
+	}
+
+	cmp.DeniedVendorGlobs = vendorGlobs
+	return nil
+}
+
+func (m *componentsAssembler) enrichWithAnyCaptureImports(
+	cmp *arch.Component,
+	yamlComponent common.Referable[spec.Component],
+	yamlDocument spec.Document,
+	anyCapture []common.Referable[string],
+) error {
+	projectImports, err := m.allowedProjectImportsAssembler.assembleListed(yamlDocument, unwrap(anyCapture))
+	if err != nil {
+		return fmt.Errorf("failed to assemble component any capture imports: %w", err)
+	}
+
+	cmp.AnyCaptureImports = wrap(yamlComponent.Reference, projectImports)
+	return nil
+}
+
+func (m *componentsAssembler) enrichWithAdditionalRules(
+	cmp *arch.Component,
+	yamlComponent common.Referable[spec.Component],
+	yamlDocument spec.Document,
+) error {
+	err := m.resolveAdditionalRules(&cmp.Tests, yamlComponent, yamlDocument)
+	if err != nil {
+		return fmt.Errorf("failed to assemble component tests rules: %w", err)
+	}
+
+	for tag, rules := range cmp.Tags {
+		err = m.resolveAdditionalRules(&rules, yamlComponent, yamlDocument)
+		if err != nil {
+			return fmt.Errorf("failed to assemble component tag '%s' rules: %w", tag, err)
+		}
+
+		cmp.Tags[tag] = rules
+	}
+
+	return nil
+}
+
+func (m *componentsAssembler) resolveAdditionalRules(
+	rules *arch.AdditionalRules,
+	yamlComponent common.Referable[spec.Component],
+	yamlDocument spec.Document,
+) error {
+	// common components and vendors is already allowed for production code
+	projectImports, err := m.allowedProjectImportsAssembler.assembleListed(yamlDocument, unwrap(rules.MayDependOn))
+	if err != nil {
+		return fmt.Errorf("failed to assemble project imports: %w", err)
+	}
+
+	vendorGlobs, err := m.allowedVendorImportsAssembler.assembleListed(yamlDocument, unwrap(rules.CanUse))
+	if err != nil {
+		return fmt.Errorf("failed to assemble vendor imports: %w", err)
+	}
+
+	rules.AllowedProjectImports = wrap(yamlComponent.Reference, projectImports)
+	rules.AllowedVendorGlobs = vendorGlobs
+	return nil
+}
+
+func additionalRules(rule spec.AdditionalRule) arch.AdditionalRules {
+	return arch.AdditionalRules{
+		MayDependOn: rule.MayDependOn(),
+		CanUse:      rule.CanUse(),
+	}
+}
+
+func (m *componentsAssembler) enrichWithSymbolRules(
+	cmp *arch.Component,
+	yamlDocument spec.Document,
+	yamlName string,
+) error {
+	depMeta, hasDeps := yamlDocument.Dependencies()[yamlName]
+	if !hasDeps {
+		return nil
+	}
+
+	symbols := depMeta.Value.Symbols()
+	targets := make([]string, 0, len(symbols))
+	for target := range symbols {
+		targets = append(targets, target)
+	}
+
+	sort.Strings(targets)
+	rules := make([]arch.SymbolRule, 0, len(targets))
+
+	for _, target := range targets {
+		projectImports, err := m.allowedProjectImportsAssembler.assembleListed(yamlDocument, []string{target})
+		if err != nil {
+			return fmt.Errorf("failed to assemble symbols '%s' project imports: %w", target, err)
+		}
+
+		vendorGlobs, err := m.allowedVendorImportsAssembler.assembleListed(yamlDocument, []string{target})
+		if err != nil {
+			return fmt.Errorf("failed to assemble symbols '%s' vendor imports: %w", target, err)
+		}
+
+		rules = append(rules, arch.SymbolRule{
+			Target:         common.NewReferable(target, depMeta.Reference),
+			Allow:          globs(symbols[target].Allow()),
+			Deny:           globs(symbols[target].Deny()),
+			ProjectImports: wrap(depMeta.Reference, projectImports),
+			VendorGlobs:    vendorGlobs,
+		})
+	}
+
+	cmp.SymbolRules = rules
+	return nil
+}
+
+func (m *componentsAssembler) enrichWithExceptions(
+	cmp *arch.Component,
+	yamlDocument spec.Document,
+	yamlName string,
+) error {
+	exceptions := make([]arch.Exception, 0)
+
+	for _, yamlException := range yamlDocument.Exceptions() {
+		if yamlException.Value.Component().Value != yamlName {
+			continue
+		}
+
+		// date in local timezone, exception is active until end of this day
+		expiresRef := yamlException.Value.Expires()
+		expires, err := time.ParseInLocation(models.ExceptionDateLayout, expiresRef.Value, time.Local)
+		if err != nil {
+			// already reported by validator
+			continue
+		}
+
+		target := yamlException.Value.Target()
+		projectImports, err := m.allowedProjectImportsAssembler.assembleListed(yamlDocument, []string{target.Value})
+		if err != nil {
+			return fmt.Errorf("failed to assemble exception '%s' project imports: %w", target.Value, err)
+		}
+
+		vendorGlobs, err := m.allowedVendorImportsAssembler.assembleListed(yamlDocument, []string{target.Value})
+		if err != nil {
+			return fmt.Errorf("failed to assemble exception '%s' vendor imports: %w", target.Value, err)
+		}
+
+		exceptions = append(exceptions, arch.Exception{
+			Target:         target,
+			Reason:         yamlException.Value.Reason(),
+			Owner:          yamlException.Value.Owner(),
+			Ticket:         yamlException.Value.Ticket(),
+			Expires:        common.NewReferable(expires, expiresRef.Reference),
+			ProjectImports: wrap(yamlException.Reference, projectImports),
+			VendorGlobs:    vendorGlobs,
+		})
+	}
+
+	cmp.Exceptions = exceptions
+	return nil
+}
@@ -0,0 +1,61 @@
+package assembler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/cycles"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type depsCyclesAssembler struct {
+}
+
+func newDepsCyclesAssembler() *depsCyclesAssembler {
+	return &depsCyclesAssembler{}
+}
+
+// assemble will suggest to fix cyclic 'mayDependOn' declarations,
+// this is valid config, but likely not what user want
+func (dca *depsCyclesAssembler) assemble(spec *arch.Spec, document spec.Document) error {
+	if !document.Options().DetectCycles().Value {
+		return nil
+	}
+
+	graph := make(cycles.Graph)
+	refs := make(map[string]map[string]common.Reference)
+
+	for name, rule := range document.Dependencies() {
+		refs[name] = make(map[string]common.Reference)
+
+		for _, dependOn := range rule.Value.MayDependOn() {
+			if dependOn.Value == name {
+				continue
+			}
+
+			graph[name] = append(graph[name], dependOn.Value)
+			refs[name][dependOn.Value] = dependOn.Reference
+		}
+	}
+
+	for _, componentNames := range cycles.StronglyConnected(graph) {
+		chain := cycles.Chain(graph, componentNames)
+		if len(chain) < 2 {
+			continue
+		}
+
+		closedBy := chain[len(chain)-2]
+
+		spec.Integrity.Suggestions = append(spec.Integrity.Suggestions, arch.Notice{
+			Notice: fmt.Errorf("cyclic deps declaration: %s (components [%s] may depend on each other)",
+				strings.Join(chain, " -> "),
+				strings.Join(componentNames, ", "),
+			),
+			Ref: refs[closedBy][chain[0]],
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package assembler
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type layersAssembler struct {
+}
+
+func newLayersAssembler() *layersAssembler {
+	return &layersAssembler{}
+}
+
+func (la *layersAssembler) assemble(spec *arch.Spec, document spec.Document) error {
+	spec.Layers = document.Layers()
+
+	return nil
+}
+
+// layerDependencies returns components from layers below component layer,
+// (or only from next layer in strict mode). Each dependency reference
+// point to line of layer in 'layers' section
+func layerDependencies(document spec.Document, componentName string) []common.Referable[string] {
+	layers := document.Layers()
+	deps := make([]common.Referable[string], 0)
+
+	for ind, layer := range layers {
+		if !inList(layer.Value, componentName) {
+			continue
+		}
+
+		for _, below := range layers[ind+1:] {
+			for _, name := range below.Value {
+				deps = append(deps, common.NewReferable(name, below.Reference))
+			}
+
+			if document.Options().StrictLayers().Value {
+				break
+			}
+		}
+
+		break
+	}
+
+	return deps
+}
+
+func inList(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,49 @@
+package assembler
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type severityAssembler struct {
+}
+
+func newSeverityAssembler() *severityAssembler {
+	return &severityAssembler{}
+}
+
+func (sa *severityAssembler) assemble(spec *arch.Spec, document spec.Document) error {
+	spec.Severity = severities(document.Severity())
+
+	return nil
+}
+
+// severities resolve level of every warnings kind, first defined level wins:
+// kind level -> default level in same place, and then same in next places.
+// When nothing is defined, warning is error
+func severities(places ...spec.Severity) arch.Severities {
+	resolve := func(kind func(spec.Severity) common.Referable[string]) common.Referable[models.Severity] {
+		for _, place := range places {
+			for _, level := range []common.Referable[string]{kind(place), place.Default()} {
+				if level.Value != "" {
+					return level
+				}
+			}
+		}
+
+		return common.NewEmptyReferable(models.SeverityError)
+	}
+
+	return arch.Severities{
+		Imports:           resolve(spec.Severity.Imports),
+		Vendors:           resolve(spec.Severity.Vendors),
+		DeepScan:          resolve(spec.Severity.DeepScan),
+		Cycles:            resolve(spec.Severity.Cycles),
+		NotMatched:        resolve(spec.Severity.NotMatched),
+		ExpiredExceptions: resolve(spec.Severity.ExpiredExceptions),
+		Std:               resolve(spec.Severity.Std),
+		Symbols:           resolve(spec.Severity.Symbols),
+	}
+}
@@ -0,0 +1,19 @@
+package assembler
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type stdAssembler struct {
+}
+
+func newStdAssembler() *stdAssembler {
+	return &stdAssembler{}
+}
+
+func (sa *stdAssembler) assemble(spec *arch.Spec, document spec.Document) error {
+	spec.DeniedStdGlobs = globs(document.CannotUseStd())
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package assembler
+
+import (
+	"sort"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type vendorsAssembler struct {
+}
+
+func newVendorsAssembler() *vendorsAssembler {
+	return &vendorsAssembler{}
+}
+
+func (va *vendorsAssembler) assemble(spec *arch.Spec, document spec.Document) error {
+	for yamlName, yamlVendor := range document.Vendors() {
+		spec.Vendors = append(spec.Vendors, arch.Vendor{
+			Name:        common.NewReferable(yamlName, yamlVendor.Reference),
+			ImportGlobs: vendorGlobs(yamlVendor),
+		})
+	}
+
+	sort.Slice(spec.Vendors, func(i, j int) bool {
+		return spec.Vendors[i].Name.Value < spec.Vendors[j].Name.Value
+	})
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package assembler
 
 import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/common"
 )
 
@@ -23,3 +24,13 @@ func unwrap[T any](refList []common.Referable[T]) []T {
 
 	return res
 }
+
+func globs(refList []common.Referable[string]) []common.Referable[models.Glob] {
+	res := make([]common.Referable[models.Glob], len(refList))
+
+	for ind, r := range refList {
+		res[ind] = common.NewReferable(models.Glob(r.Value), r.Reference)
+	}
+
+	return res
+}
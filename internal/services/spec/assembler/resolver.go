@@ -6,30 +6,32 @@ import (
 	"strings"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 )
 
 type resolver struct {
 	pathResolver  pathResolver
 	rootDirectory string
-	moduleName    string
+	modules       common.Modules
 }
 
 func newResolver(
 	pathResolver pathResolver,
 	rootDirectory string,
-	moduleName string,
+	modules common.Modules,
 ) *resolver {
 	return &resolver{
 		pathResolver:  pathResolver,
 		rootDirectory: rootDirectory,
-		moduleName:    moduleName,
+		modules:       modules,
 	}
 }
 
 func (r *resolver) resolveLocalGlobPath(localGlobPath string) ([]models.ResolvedPath, error) {
 	list := make([]models.ResolvedPath, 0)
 
-	absPath := fmt.Sprintf("%s/%s", r.rootDirectory, localGlobPath)
+	glob := models.Glob(localGlobPath)
+	absPath := fmt.Sprintf("%s/%s", r.rootDirectory, glob.WithoutCaptures())
 	resolved, err := r.pathResolver.Resolve(absPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve path '%s'", absPath)
@@ -38,12 +40,21 @@ func (r *resolver) resolveLocalGlobPath(localGlobPath string) ([]models.Resolved
 	for _, absResolvedPath := range resolved {
 		localPath := strings.TrimPrefix(absResolvedPath, fmt.Sprintf("%s/", r.rootDirectory))
 		localPath = strings.TrimRight(localPath, "/")
-		importPath := fmt.Sprintf("%s/%s", r.moduleName, localPath)
+		absPath := filepath.Clean(strings.TrimRight(absResolvedPath, "/"))
+
+		// in workspace mode, path can be in any module
+		importPath, found := r.modules.ImportPath(absPath)
+		if !found {
+			// directory outside of go modules, it can't be imported
+			importPath = localPath
+		}
 
 		list = append(list, models.ResolvedPath{
 			ImportPath: strings.TrimRight(importPath, "/"),
 			LocalPath:  strings.TrimRight(localPath, "/"),
-			AbsPath:    filepath.Clean(strings.TrimRight(absResolvedPath, "/")),
+			AbsPath:    absPath,
+			Glob:       glob,
+			Captures:   glob.Captures(strings.TrimRight(localPath, "/")),
 		})
 	}
 
@@ -2,16 +2,18 @@ package assembler
 
 import (
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
 )
 
 type (
 	archDecoder interface {
 		Decode(archFile string) (spec.Document, []arch.Notice, error)
+		DecodeSource(archFile string, sourceCode []byte) (spec.Document, []arch.Notice, error)
 	}
 
 	archValidator interface {
-		Validate(doc spec.Document, projectDir string) []arch.Notice
+		Validate(doc spec.Document, projectDir string, modules common.Modules) []arch.Notice
 	}
 
 	pathResolver interface {
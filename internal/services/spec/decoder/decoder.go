@@ -33,6 +33,12 @@ func (sp *Decoder) Decode(archFile string) (spec.Document, []arch.Notice, error)
 		return nil, nil, fmt.Errorf("failed to provide source code of archfile: %w", err)
 	}
 
+	return sp.DecodeSource(archFile, sourceCode)
+}
+
+// DecodeSource is same as Decode, but arch file source code is already in memory,
+// archFile path is used only for references in notices
+func (sp *Decoder) DecodeSource(archFile string, sourceCode []byte) (spec.Document, []arch.Notice, error) {
 	// read only doc Version
 	documentVersion, err := sp.readVersion(sourceCode)
 	if err != nil {
@@ -55,10 +61,26 @@ func (sp *Decoder) Decode(archFile string) (spec.Document, []arch.Notice, error)
 		return nil, nil, fmt.Errorf("failed to parse arch file (yaml): %w", err)
 	}
 
+	if len(schemeNotices) == 0 {
+		schemeNotices = sp.include(document, archFile)
+	}
+
+	document.postSetup()
 	return document, schemeNotices, nil
 }
 
 func (sp *Decoder) decodeDocument(version int, sourceCode []byte, filePath string) (doc, error) {
+	document := sp.createEmptyDocumentBeVersion(version)
+
+	err := sp.decodeYaml(sourceCode, filePath, document)
+	if err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+func (sp *Decoder) decodeYaml(sourceCode []byte, filePath string, target any) error {
 	reader := bytes.NewBuffer(sourceCode)
 	decoder := yaml.NewDecoder(
 		reader,
@@ -68,15 +90,7 @@ func (sp *Decoder) decodeDocument(version int, sourceCode []byte, filePath strin
 	)
 
 	decodeCtx := context.WithValue(context.Background(), yamlParentFileCtx{}, filePath)
-	document := sp.createEmptyDocumentBeVersion(version)
-
-	err := decoder.DecodeContext(decodeCtx, document)
-	if err != nil {
-		return nil, err
-	}
-
-	document.postSetup()
-	return document, nil
+	return decoder.DecodeContext(decodeCtx, target)
 }
 
 func (sp *Decoder) createEmptyDocumentBeVersion(version int) doc {
@@ -85,10 +99,12 @@ func (sp *Decoder) createEmptyDocumentBeVersion(version int) doc {
 		return &ArchV1{}
 	case 2:
 		return &ArchV2{}
+	case 3:
+		return &ArchV3{}
 	}
 
-	// latest be default (it will be rejected next in spec validator, if version is not v3)
-	return &ArchV3{}
+	// latest be default (it will be rejected next in spec validator, if version is not v4)
+	return &ArchV4{}
 }
 
 func (sp *Decoder) readVersion(sourceCode []byte) (int, error) {
@@ -127,7 +143,7 @@ func (sp *Decoder) jsonSchemeValidate(schemeVersion int, sourceCode []byte, file
 	for _, jsonNotice := range jsonNotices {
 		schemeRef := common.NewEmptyReference()
 		if jsonNotice.yamlPath != nil {
-			schemeRef = sp.yamlReferenceResolver.Resolve(filePath, *jsonNotice.yamlPath)
+			schemeRef = sp.yamlReferenceResolver.Resolve(filePath, sourceCode, *jsonNotice.yamlPath)
 		}
 
 		schemeNotices = append(schemeNotices, arch.Notice{
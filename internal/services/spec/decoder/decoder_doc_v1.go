@@ -97,6 +97,33 @@ func (a *ArchV1) Dependencies() spec.Dependencies {
 	return casted
 }
 
+func (a *ArchV1) Layers() []common.Referable[[]string] {
+	return []common.Referable[[]string]{}
+}
+
+func (a *ArchV1) Exceptions() spec.Exceptions {
+	return spec.Exceptions{}
+}
+
+func (a *ArchV1) Severity() spec.Severity {
+	// not supported before v4, all warnings is errors
+	return ArchV4Severity{}
+}
+
+func (a *ArchV1) Build() spec.Build {
+	// not supported before v4, all files is scanned
+	return ArchV4Build{}
+}
+
+func (a *ArchV1) CannotUseStd() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a *ArchV1) IncludedFiles() []string {
+	// not supported before v4
+	return []string{}
+}
+
 // --
 
 func (a ArchV1Allow) IsDependOnAnyVendor() common.Referable[bool] {
@@ -107,18 +134,44 @@ func (a ArchV1Allow) DeepScan() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
 
+func (a ArchV1Allow) DetectCycles() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV1Allow) StrictLayers() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV1Allow) IgnoreTests() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
 // --
 
 func (a ArchV1Vendor) ImportPaths() []models.Glob {
 	return []models.Glob{models.Glob(a.FImportPath)}
 }
 
+func (a ArchV1Vendor) Modules() []models.Glob {
+	// not supported before v4
+	return []models.Glob{}
+}
+
+func (a ArchV1Vendor) Version() common.Referable[string] {
+	return common.NewEmptyReferable("")
+}
+
 // --
 
 func (a ArchV1Component) RelativePaths() []models.Glob {
 	return []models.Glob{models.Glob(a.FLocalPath)}
 }
 
+func (a ArchV1Component) Exports() []models.Glob {
+	// not supported before v4, all component packages is exported
+	return []models.Glob{}
+}
+
 // --
 
 func (a ArchV1Rule) MayDependOn() []common.Referable[string] {
@@ -140,3 +193,43 @@ func (a ArchV1Rule) AnyVendorDeps() common.Referable[bool] {
 func (a ArchV1Rule) DeepScan() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
+
+func (a ArchV1Rule) SameCapture() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV1Rule) AnyCapture() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV1Rule) Severity() spec.Severity {
+	return ArchV4Severity{}
+}
+
+func (a ArchV1Rule) Tests() spec.AdditionalRule {
+	return ArchV4AdditionalRule{}
+}
+
+func (a ArchV1Rule) Tags() map[string]spec.AdditionalRule {
+	return map[string]spec.AdditionalRule{}
+}
+
+func (a ArchV1Rule) Symbols() map[string]spec.SymbolRule {
+	return map[string]spec.SymbolRule{}
+}
+
+func (a ArchV1Rule) MayNotDependOn() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV1Rule) CannotUse() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV1Rule) CanUseStd() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV1Rule) CannotUseStd() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
@@ -107,6 +107,33 @@ func (a *ArchV2) Dependencies() spec.Dependencies {
 	return casted
 }
 
+func (a *ArchV2) Layers() []common.Referable[[]string] {
+	return []common.Referable[[]string]{}
+}
+
+func (a *ArchV2) Exceptions() spec.Exceptions {
+	return spec.Exceptions{}
+}
+
+func (a *ArchV2) Severity() spec.Severity {
+	// not supported before v4, all warnings is errors
+	return ArchV4Severity{}
+}
+
+func (a *ArchV2) Build() spec.Build {
+	// not supported before v4, all files is scanned
+	return ArchV4Build{}
+}
+
+func (a *ArchV2) CannotUseStd() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a *ArchV2) IncludedFiles() []string {
+	// not supported before v4
+	return []string{}
+}
+
 // --
 
 func (a ArchV2Allow) IsDependOnAnyVendor() common.Referable[bool] {
@@ -117,6 +144,18 @@ func (a ArchV2Allow) DeepScan() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
 
+func (a ArchV2Allow) DetectCycles() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV2Allow) StrictLayers() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV2Allow) IgnoreTests() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
 // --
 
 func (a ArchV2Vendor) ImportPaths() []models.Glob {
@@ -129,6 +168,15 @@ func (a ArchV2Vendor) ImportPaths() []models.Glob {
 	return casted
 }
 
+func (a ArchV2Vendor) Modules() []models.Glob {
+	// not supported before v4
+	return []models.Glob{}
+}
+
+func (a ArchV2Vendor) Version() common.Referable[string] {
+	return common.NewEmptyReferable("")
+}
+
 // --
 
 func (a ArchV2Component) RelativePaths() []models.Glob {
@@ -141,6 +189,11 @@ func (a ArchV2Component) RelativePaths() []models.Glob {
 	return casted
 }
 
+func (a ArchV2Component) Exports() []models.Glob {
+	// not supported before v4, all component packages is exported
+	return []models.Glob{}
+}
+
 // --
 
 func (a ArchV2Rule) MayDependOn() []common.Referable[string] {
@@ -162,3 +215,43 @@ func (a ArchV2Rule) AnyVendorDeps() common.Referable[bool] {
 func (a ArchV2Rule) DeepScan() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
+
+func (a ArchV2Rule) SameCapture() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV2Rule) AnyCapture() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV2Rule) Severity() spec.Severity {
+	return ArchV4Severity{}
+}
+
+func (a ArchV2Rule) Tests() spec.AdditionalRule {
+	return ArchV4AdditionalRule{}
+}
+
+func (a ArchV2Rule) Tags() map[string]spec.AdditionalRule {
+	return map[string]spec.AdditionalRule{}
+}
+
+func (a ArchV2Rule) Symbols() map[string]spec.SymbolRule {
+	return map[string]spec.SymbolRule{}
+}
+
+func (a ArchV2Rule) MayNotDependOn() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV2Rule) CannotUse() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV2Rule) CanUseStd() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV2Rule) CannotUseStd() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
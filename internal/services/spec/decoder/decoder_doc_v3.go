@@ -123,6 +123,33 @@ func (a *ArchV3) Dependencies() spec.Dependencies {
 	return casted
 }
 
+func (a *ArchV3) Layers() []common.Referable[[]string] {
+	return []common.Referable[[]string]{}
+}
+
+func (a *ArchV3) Exceptions() spec.Exceptions {
+	return spec.Exceptions{}
+}
+
+func (a *ArchV3) Severity() spec.Severity {
+	// not supported before v4, all warnings is errors
+	return ArchV4Severity{}
+}
+
+func (a *ArchV3) Build() spec.Build {
+	// not supported before v4, all files is scanned
+	return ArchV4Build{}
+}
+
+func (a *ArchV3) CannotUseStd() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a *ArchV3) IncludedFiles() []string {
+	// not supported before v4
+	return []string{}
+}
+
 // --
 
 func (a ArchV3Allow) IsDependOnAnyVendor() common.Referable[bool] {
@@ -138,6 +165,18 @@ func (a ArchV3Allow) DeepScan() common.Referable[bool] {
 	return common.NewEmptyReferable(true)
 }
 
+func (a ArchV3Allow) DetectCycles() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV3Allow) StrictLayers() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV3Allow) IgnoreTests() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
 // --
 
 func (a ArchV3Vendor) ImportPaths() []models.Glob {
@@ -150,6 +189,15 @@ func (a ArchV3Vendor) ImportPaths() []models.Glob {
 	return casted
 }
 
+func (a ArchV3Vendor) Modules() []models.Glob {
+	// not supported before v4
+	return []models.Glob{}
+}
+
+func (a ArchV3Vendor) Version() common.Referable[string] {
+	return common.NewEmptyReferable("")
+}
+
 // --
 
 func (a ArchV3Component) RelativePaths() []models.Glob {
@@ -162,6 +210,11 @@ func (a ArchV3Component) RelativePaths() []models.Glob {
 	return casted
 }
 
+func (a ArchV3Component) Exports() []models.Glob {
+	// not supported before v4, all component packages is exported
+	return []models.Glob{}
+}
+
 // --
 
 func (a ArchV3Rule) MayDependOn() []common.Referable[string] {
@@ -183,3 +236,43 @@ func (a ArchV3Rule) AnyVendorDeps() common.Referable[bool] {
 func (a ArchV3Rule) DeepScan() common.Referable[bool] {
 	return a.FDeepScan.ref
 }
+
+func (a ArchV3Rule) SameCapture() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV3Rule) AnyCapture() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV3Rule) Severity() spec.Severity {
+	return ArchV4Severity{}
+}
+
+func (a ArchV3Rule) Tests() spec.AdditionalRule {
+	return ArchV4AdditionalRule{}
+}
+
+func (a ArchV3Rule) Tags() map[string]spec.AdditionalRule {
+	return map[string]spec.AdditionalRule{}
+}
+
+func (a ArchV3Rule) Symbols() map[string]spec.SymbolRule {
+	return map[string]spec.SymbolRule{}
+}
+
+func (a ArchV3Rule) MayNotDependOn() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV3Rule) CannotUse() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV3Rule) CanUseStd() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV3Rule) CannotUseStd() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
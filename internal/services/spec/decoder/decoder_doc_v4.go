@@ -0,0 +1,518 @@
+package decoder
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type (
+	// ArchV4 changes since ArchV3:
+	// - added deny lists "mayNotDependOn" and "cannotUse" in deps rules,
+	//   deny rules always take precedence over any allow rules
+	// - added detectCycles option in allow
+	// - added include of other local yaml files (see ArchV4Fragment)
+	// - added layers shorthand, each layer may depend on layers below it
+	// - added named wildcards in component paths ("domain/{ctx}/service") and
+	//   "sameCapture", "anyCapture" in deps rules for isolation of this instances
+	// - added exceptions, temporary allowed deps with expiry date
+	// - added severity of warnings (global and in deps rules)
+	// - added "tests" rules in deps and ignoreTests option in allow
+	// - added build constraints evaluation and "tags" rules in deps
+	// - added std packages rules "canUseStd", "cannotUseStd" (global and in deps)
+	// - added component "exports", only this packages can be imported by other components
+	ArchV4 struct {
+		FVersion            ref[int]                                    `json:"version"`
+		FInclude            []ref[string]                               `json:"include"`
+		FWorkDir            ref[string]                                 `json:"workdir"`
+		FAllow              ArchV4Allow                                 `json:"allow"`
+		FExclude            []ref[string]                               `json:"exclude"`
+		FExcludeFilesRegExp []ref[string]                               `json:"excludeFiles"`
+		FVendors            map[spec.VendorName]ref[ArchV4Vendor]       `json:"vendors"`
+		FCommonVendors      []ref[string]                               `json:"commonVendors"`
+		FComponents         map[spec.ComponentName]ref[ArchV4Component] `json:"components"`
+		FCommonComponents   []ref[string]                               `json:"commonComponents"`
+		FDependencies       map[spec.ComponentName]ref[ArchV4Rule]      `json:"deps"`
+		FLayers             []ref[stringList]                           `json:"layers"`
+		FExceptions         []ref[ArchV4Exception]                      `json:"exceptions"`
+		FSeverity           ref[ArchV4Severity]                         `json:"severity"`
+		FBuild              ref[ArchV4Build]                            `json:"build"`
+		FCannotUseStd       []ref[string]                               `json:"cannotUseStd"`
+
+		includedFiles []string
+	}
+
+	ArchV4Build struct {
+		FGOOS    ref[string]       `json:"goos"`
+		FGOARCH  ref[string]       `json:"goarch"`
+		FTags    []ref[string]     `json:"tags"`
+		FTagSets []ref[stringList] `json:"tagSets"`
+
+		defined bool
+	}
+
+	ArchV4Allow struct {
+		FDepOnAnyVendor ref[bool] `json:"depOnAnyVendor"`
+		FDeepScan       ref[bool] `json:"deepScan"`
+		FDetectCycles   ref[bool] `json:"detectCycles"`
+		FStrictLayers   ref[bool] `json:"strictLayers"`
+		FIgnoreTests    ref[bool] `json:"ignoreTests"`
+	}
+
+	ArchV4Vendor struct {
+		FImportPaths stringList  `json:"in"`
+		FModules     stringList  `json:"module"`
+		FVersion     ref[string] `json:"version"`
+	}
+
+	ArchV4Component struct {
+		FLocalPaths stringList `json:"in"`
+		FExports    stringList `json:"exports"`
+	}
+
+	ArchV4Rule struct {
+		FMayDependOn    []ref[string]                   `json:"mayDependOn"`
+		FMayNotDependOn []ref[string]                   `json:"mayNotDependOn"`
+		FCanUse         []ref[string]                   `json:"canUse"`
+		FCannotUse      []ref[string]                   `json:"cannotUse"`
+		FCanUseStd      []ref[string]                   `json:"canUseStd"`
+		FCannotUseStd   []ref[string]                   `json:"cannotUseStd"`
+		FAnyProjectDeps ref[bool]                       `json:"anyProjectDeps"`
+		FAnyVendorDeps  ref[bool]                       `json:"anyVendorDeps"`
+		FDeepScan       ref[bool]                       `json:"deepScan"`
+		FSameCapture    ref[bool]                       `json:"sameCapture"`
+		FAnyCapture     []ref[string]                   `json:"anyCapture"`
+		FSeverity       ref[ArchV4Severity]             `json:"severity"`
+		FTests          ArchV4AdditionalRule            `json:"tests"`
+		FTags           map[string]ArchV4AdditionalRule `json:"tags"`
+		FSymbols        map[string]ArchV4SymbolRule     `json:"symbols"`
+	}
+
+	ArchV4SymbolRule struct {
+		FAllow []ref[string] `json:"allow"`
+		FDeny  []ref[string] `json:"deny"`
+	}
+
+	ArchV4AdditionalRule struct {
+		FMayDependOn []ref[string] `json:"mayDependOn"`
+		FCanUse      []ref[string] `json:"canUse"`
+	}
+
+	ArchV4Exception struct {
+		FComponent ref[string] `json:"component"`
+		FTarget    ref[string] `json:"target"`
+		FReason    ref[string] `json:"reason"`
+		FOwner     ref[string] `json:"owner"`
+		FTicket    ref[string] `json:"ticket"`
+		FExpires   ref[string] `json:"expires"`
+	}
+
+	// ArchV4Severity can be defined as one level for all warnings ("severity: warning"),
+	// or as map with level for each warnings kind
+	ArchV4Severity struct {
+		FDefault           string `json:"default"`
+		FImports           string `json:"imports"`
+		FVendors           string `json:"vendors"`
+		FDeepScan          string `json:"deepScan"`
+		FCycles            string `json:"cycles"`
+		FNotMatched        string `json:"notMatched"`
+		FExpiredExceptions string `json:"expiredExceptions"`
+		FStd               string `json:"std"`
+		FSymbols           string `json:"symbols"`
+
+		reference common.Reference
+	}
+)
+
+func (a *ArchV4) postSetup() {
+	// deep scan nesting (global settings -> local settings)
+	for depName := range a.FDependencies {
+		localDeepScan := a.FDependencies[depName].ref.Value.FDeepScan
+
+		if !localDeepScan.defined {
+			dep := a.FDependencies[depName]
+			dep.ref.Value.FDeepScan = ref[bool]{
+				defined: true,
+				ref:     a.FAllow.DeepScan(),
+			}
+
+			a.FDependencies[depName] = dep
+		}
+	}
+}
+
+func (a *ArchV4) Version() common.Referable[int] {
+	return castRef(a.FVersion)
+}
+
+func (a *ArchV4) WorkingDirectory() common.Referable[string] {
+	// fallback from version 1
+	actualWorkDirectory := "./"
+
+	if a.FWorkDir.ref.Value != "" {
+		actualWorkDirectory = a.FWorkDir.ref.Value
+	}
+
+	return common.NewReferable(actualWorkDirectory, a.FWorkDir.ref.Reference)
+}
+
+func (a *ArchV4) Options() spec.Options {
+	return a.FAllow
+}
+
+func (a *ArchV4) ExcludedDirectories() []common.Referable[string] {
+	return castRefList(a.FExclude)
+}
+
+func (a *ArchV4) ExcludedFilesRegExp() []common.Referable[string] {
+	return castRefList(a.FExcludeFilesRegExp)
+}
+
+func (a *ArchV4) Vendors() spec.Vendors {
+	casted := make(spec.Vendors, len(a.FVendors))
+	for name, vendor := range a.FVendors {
+		casted[name] = common.NewReferable(spec.Vendor(vendor.ref.Value), vendor.ref.Reference)
+	}
+
+	return casted
+}
+
+func (a *ArchV4) CommonVendors() []common.Referable[string] {
+	return castRefList(a.FCommonVendors)
+}
+
+func (a *ArchV4) Components() spec.Components {
+	casted := make(spec.Components, len(a.FComponents))
+	for name, cmp := range a.FComponents {
+		casted[name] = common.NewReferable(spec.Component(cmp.ref.Value), cmp.ref.Reference)
+	}
+
+	return casted
+}
+
+func (a *ArchV4) CommonComponents() []common.Referable[string] {
+	return castRefList(a.FCommonComponents)
+}
+
+func (a *ArchV4) Dependencies() spec.Dependencies {
+	casted := make(spec.Dependencies, len(a.FDependencies))
+	for name, dep := range a.FDependencies {
+		casted[name] = common.NewReferable(spec.DependencyRule(dep.ref.Value), dep.ref.Reference)
+	}
+
+	return casted
+}
+
+func (a *ArchV4) Layers() []common.Referable[[]string] {
+	casted := make([]common.Referable[[]string], 0, len(a.FLayers))
+	for _, layer := range a.FLayers {
+		casted = append(casted, common.NewReferable([]string(layer.ref.Value), layer.ref.Reference))
+	}
+
+	return casted
+}
+
+func (a *ArchV4) Exceptions() spec.Exceptions {
+	casted := make(spec.Exceptions, 0, len(a.FExceptions))
+	for _, exception := range a.FExceptions {
+		casted = append(casted, common.NewReferable(spec.Exception(exception.ref.Value), exception.ref.Reference))
+	}
+
+	return casted
+}
+
+func (a *ArchV4) Severity() spec.Severity {
+	return castSeverity(a.FSeverity)
+}
+
+func (a *ArchV4) Build() spec.Build {
+	build := a.FBuild.ref.Value
+	build.defined = a.FBuild.defined
+
+	return build
+}
+
+func (a *ArchV4) CannotUseStd() []common.Referable[string] {
+	return castRefList(a.FCannotUseStd)
+}
+
+func (a *ArchV4) IncludedFiles() []string {
+	return a.includedFiles
+}
+
+// --
+
+func (a ArchV4Allow) IsDependOnAnyVendor() common.Referable[bool] {
+	return castRef(a.FDepOnAnyVendor)
+}
+
+func (a ArchV4Allow) DeepScan() common.Referable[bool] {
+	if a.FDeepScan.defined {
+		return a.FDeepScan.ref
+	}
+
+	// be default it`s on from V3+
+	return common.NewEmptyReferable(true)
+}
+
+func (a ArchV4Allow) DetectCycles() common.Referable[bool] {
+	return castRef(a.FDetectCycles)
+}
+
+func (a ArchV4Allow) StrictLayers() common.Referable[bool] {
+	return castRef(a.FStrictLayers)
+}
+
+func (a ArchV4Allow) IgnoreTests() common.Referable[bool] {
+	return castRef(a.FIgnoreTests)
+}
+
+// --
+
+func (a ArchV4Vendor) ImportPaths() []models.Glob {
+	casted := make([]models.Glob, 0, len(a.FImportPaths))
+
+	for _, path := range a.FImportPaths {
+		casted = append(casted, models.Glob(path))
+	}
+
+	return casted
+}
+
+func (a ArchV4Vendor) Modules() []models.Glob {
+	casted := make([]models.Glob, 0, len(a.FModules))
+
+	for _, path := range a.FModules {
+		casted = append(casted, models.Glob(path))
+	}
+
+	return casted
+}
+
+func (a ArchV4Vendor) Version() common.Referable[string] {
+	return castRef(a.FVersion)
+}
+
+// --
+
+func (a ArchV4Component) RelativePaths() []models.Glob {
+	casted := make([]models.Glob, 0, len(a.FLocalPaths))
+
+	for _, path := range a.FLocalPaths {
+		casted = append(casted, models.Glob(path))
+	}
+
+	return casted
+}
+
+func (a ArchV4Component) Exports() []models.Glob {
+	casted := make([]models.Glob, 0, len(a.FExports))
+
+	for _, path := range a.FExports {
+		casted = append(casted, models.Glob(path))
+	}
+
+	return casted
+}
+
+// --
+
+func (a ArchV4Rule) MayDependOn() []common.Referable[string] {
+	return castRefList(a.FMayDependOn)
+}
+
+func (a ArchV4Rule) MayNotDependOn() []common.Referable[string] {
+	return castRefList(a.FMayNotDependOn)
+}
+
+func (a ArchV4Rule) CanUse() []common.Referable[string] {
+	return castRefList(a.FCanUse)
+}
+
+func (a ArchV4Rule) CannotUse() []common.Referable[string] {
+	return castRefList(a.FCannotUse)
+}
+
+func (a ArchV4Rule) CanUseStd() []common.Referable[string] {
+	return castRefList(a.FCanUseStd)
+}
+
+func (a ArchV4Rule) CannotUseStd() []common.Referable[string] {
+	return castRefList(a.FCannotUseStd)
+}
+
+func (a ArchV4Rule) AnyProjectDeps() common.Referable[bool] {
+	return castRef(a.FAnyProjectDeps)
+}
+
+func (a ArchV4Rule) AnyVendorDeps() common.Referable[bool] {
+	return castRef(a.FAnyVendorDeps)
+}
+
+func (a ArchV4Rule) DeepScan() common.Referable[bool] {
+	return a.FDeepScan.ref
+}
+
+func (a ArchV4Rule) SameCapture() common.Referable[bool] {
+	return castRef(a.FSameCapture)
+}
+
+func (a ArchV4Rule) AnyCapture() []common.Referable[string] {
+	return castRefList(a.FAnyCapture)
+}
+
+func (a ArchV4Rule) Severity() spec.Severity {
+	return castSeverity(a.FSeverity)
+}
+
+func (a ArchV4Rule) Tests() spec.AdditionalRule {
+	return a.FTests
+}
+
+func (a ArchV4Rule) Tags() map[string]spec.AdditionalRule {
+	casted := make(map[string]spec.AdditionalRule, len(a.FTags))
+	for tag, rule := range a.FTags {
+		casted[tag] = rule
+	}
+
+	return casted
+}
+
+func (a ArchV4Rule) Symbols() map[string]spec.SymbolRule {
+	casted := make(map[string]spec.SymbolRule, len(a.FSymbols))
+	for name, rule := range a.FSymbols {
+		casted[name] = rule
+	}
+
+	return casted
+}
+
+// --
+
+func (a ArchV4AdditionalRule) MayDependOn() []common.Referable[string] {
+	return castRefList(a.FMayDependOn)
+}
+
+func (a ArchV4AdditionalRule) CanUse() []common.Referable[string] {
+	return castRefList(a.FCanUse)
+}
+
+// --
+
+func (a ArchV4SymbolRule) Allow() []common.Referable[string] {
+	return castRefList(a.FAllow)
+}
+
+func (a ArchV4SymbolRule) Deny() []common.Referable[string] {
+	return castRefList(a.FDeny)
+}
+
+// --
+
+func (a ArchV4Build) Defined() bool {
+	return a.defined
+}
+
+func (a ArchV4Build) GOOS() common.Referable[string] {
+	return castRef(a.FGOOS)
+}
+
+func (a ArchV4Build) GOARCH() common.Referable[string] {
+	return castRef(a.FGOARCH)
+}
+
+func (a ArchV4Build) Tags() []common.Referable[string] {
+	return castRefList(a.FTags)
+}
+
+func (a ArchV4Build) TagSets() []common.Referable[[]string] {
+	casted := make([]common.Referable[[]string], 0, len(a.FTagSets))
+	for _, tagSet := range a.FTagSets {
+		casted = append(casted, common.NewReferable([]string(tagSet.ref.Value), tagSet.ref.Reference))
+	}
+
+	return casted
+}
+
+// --
+
+func (a ArchV4Exception) Component() common.Referable[string] {
+	return castRef(a.FComponent)
+}
+
+func (a ArchV4Exception) Target() common.Referable[string] {
+	return castRef(a.FTarget)
+}
+
+func (a ArchV4Exception) Reason() common.Referable[string] {
+	return castRef(a.FReason)
+}
+
+func (a ArchV4Exception) Owner() common.Referable[string] {
+	return castRef(a.FOwner)
+}
+
+func (a ArchV4Exception) Ticket() common.Referable[string] {
+	return castRef(a.FTicket)
+}
+
+func (a ArchV4Exception) Expires() common.Referable[string] {
+	return castRef(a.FExpires)
+}
+
+// --
+
+func (a *ArchV4Severity) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var level string
+	if err := unmarshal(&level); err == nil {
+		*a = ArchV4Severity{FDefault: level}
+		return nil
+	}
+
+	type plain ArchV4Severity
+	return unmarshal((*plain)(a))
+}
+
+// castSeverity attach reference of severity node to all levels
+func castSeverity(r ref[ArchV4Severity]) ArchV4Severity {
+	severity := r.ref.Value
+	severity.reference = r.ref.Reference
+
+	return severity
+}
+
+func (a ArchV4Severity) Default() common.Referable[string] {
+	return common.NewReferable(a.FDefault, a.reference)
+}
+
+func (a ArchV4Severity) Imports() common.Referable[string] {
+	return common.NewReferable(a.FImports, a.reference)
+}
+
+func (a ArchV4Severity) Vendors() common.Referable[string] {
+	return common.NewReferable(a.FVendors, a.reference)
+}
+
+func (a ArchV4Severity) DeepScan() common.Referable[string] {
+	return common.NewReferable(a.FDeepScan, a.reference)
+}
+
+func (a ArchV4Severity) Cycles() common.Referable[string] {
+	return common.NewReferable(a.FCycles, a.reference)
+}
+
+func (a ArchV4Severity) NotMatched() common.Referable[string] {
+	return common.NewReferable(a.FNotMatched, a.reference)
+}
+
+func (a ArchV4Severity) ExpiredExceptions() common.Referable[string] {
+	return common.NewReferable(a.FExpiredExceptions, a.reference)
+}
+
+func (a ArchV4Severity) Std() common.Referable[string] {
+	return common.NewReferable(a.FStd, a.reference)
+}
+
+func (a ArchV4Severity) Symbols() common.Referable[string] {
+	return common.NewReferable(a.FSymbols, a.reference)
+}
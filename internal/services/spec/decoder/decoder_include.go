@@ -0,0 +1,263 @@
+package decoder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type (
+	// ArchV4Fragment is content of included (v4+) file, it can't have
+	// own version, workdir or allow settings, only shared definitions
+	ArchV4Fragment struct {
+		FInclude            []ref[string]                               `json:"include"`
+		FExclude            []ref[string]                               `json:"exclude"`
+		FExcludeFilesRegExp []ref[string]                               `json:"excludeFiles"`
+		FVendors            map[spec.VendorName]ref[ArchV4Vendor]       `json:"vendors"`
+		FCommonVendors      []ref[string]                               `json:"commonVendors"`
+		FComponents         map[spec.ComponentName]ref[ArchV4Component] `json:"components"`
+		FCommonComponents   []ref[string]                               `json:"commonComponents"`
+		FDependencies       map[spec.ComponentName]ref[ArchV4Rule]      `json:"deps"`
+	}
+
+	includeContext struct {
+		merged  ArchV4Fragment
+		stack   []string
+		visited map[string]struct{}
+		files   []string // included files in order of visiting
+		notices []arch.Notice
+	}
+)
+
+// include will merge all included files into document, merge rules:
+//   - files merged depth-first in order of 'include' lists, current file is always last
+//   - components, vendors: later definition with same name replace previous
+//   - deps: lists are merged (unique values), flags replaced only when defined
+//   - exclude, excludeFiles, commonVendors, commonComponents: unique values from all files
+//   - every file included only once, even if it listed in different files
+func (sp *Decoder) include(document doc, archFile string) []arch.Notice {
+	root, ok := document.(*ArchV4)
+	if !ok || len(root.FInclude) == 0 {
+		return nil
+	}
+
+	ctx := &includeContext{
+		stack:   []string{filepath.Clean(archFile)},
+		visited: map[string]struct{}{filepath.Clean(archFile): {}},
+	}
+
+	sp.includeFiles(ctx, root.FInclude, archFile)
+
+	// even not valid files, so they can be fixed and reloaded in watch mode
+	root.includedFiles = ctx.files
+
+	if len(ctx.notices) > 0 {
+		return ctx.notices
+	}
+
+	ctx.merged.merge(ArchV4Fragment{
+		FExclude:            root.FExclude,
+		FExcludeFilesRegExp: root.FExcludeFilesRegExp,
+		FVendors:            root.FVendors,
+		FCommonVendors:      root.FCommonVendors,
+		FComponents:         root.FComponents,
+		FCommonComponents:   root.FCommonComponents,
+		FDependencies:       root.FDependencies,
+	})
+
+	root.FExclude = ctx.merged.FExclude
+	root.FExcludeFilesRegExp = ctx.merged.FExcludeFilesRegExp
+	root.FVendors = ctx.merged.FVendors
+	root.FCommonVendors = ctx.merged.FCommonVendors
+	root.FComponents = ctx.merged.FComponents
+	root.FCommonComponents = ctx.merged.FCommonComponents
+	root.FDependencies = ctx.merged.FDependencies
+
+	return nil
+}
+
+func (sp *Decoder) includeFiles(ctx *includeContext, includes []ref[string], parentFile string) {
+	for _, include := range includes {
+		filePath := include.ref.Value
+		if !filepath.IsAbs(filePath) {
+			filePath = filepath.Join(filepath.Dir(parentFile), filePath)
+		}
+
+		filePath = filepath.Clean(filePath)
+
+		if inIncludeStack(ctx, filePath) {
+			// other includes of this file can be still valid
+			ctx.notices = append(ctx.notices, arch.Notice{
+				Notice: fmt.Errorf("include cycle: %s -> %s", strings.Join(ctx.stack, " -> "), filePath),
+				Ref:    include.ref.Reference,
+			})
+
+			continue
+		}
+
+		if _, visited := ctx.visited[filePath]; visited {
+			continue
+		}
+
+		ctx.visited[filePath] = struct{}{}
+		ctx.files = append(ctx.files, filePath)
+
+		sourceCode, err := os.ReadFile(filePath)
+		if err != nil {
+			ctx.notices = append(ctx.notices, arch.Notice{
+				Notice: fmt.Errorf("failed to read included file '%s': %w", include.ref.Value, err),
+				Ref:    include.ref.Reference,
+			})
+
+			continue
+		}
+
+		fragment := ArchV4Fragment{}
+		err = sp.decodeYaml(sourceCode, filePath, &fragment)
+		if err != nil {
+			ctx.notices = append(ctx.notices, arch.Notice{
+				Notice: fmt.Errorf("failed to parse included file '%s': %w", include.ref.Value, err),
+				Ref:    include.ref.Reference,
+			})
+
+			continue
+		}
+
+		ctx.stack = append(ctx.stack, filePath)
+		sp.includeFiles(ctx, fragment.FInclude, filePath)
+		ctx.stack = ctx.stack[:len(ctx.stack)-1]
+
+		ctx.merged.merge(fragment)
+	}
+}
+
+func (f *ArchV4Fragment) merge(another ArchV4Fragment) {
+	f.FExclude = mergeRefList(f.FExclude, another.FExclude)
+	f.FExcludeFilesRegExp = mergeRefList(f.FExcludeFilesRegExp, another.FExcludeFilesRegExp)
+	f.FCommonVendors = mergeRefList(f.FCommonVendors, another.FCommonVendors)
+	f.FCommonComponents = mergeRefList(f.FCommonComponents, another.FCommonComponents)
+	f.FVendors = mergeRefMap(f.FVendors, another.FVendors)
+	f.FComponents = mergeRefMap(f.FComponents, another.FComponents)
+
+	if f.FDependencies == nil {
+		f.FDependencies = map[spec.ComponentName]ref[ArchV4Rule]{}
+	}
+
+	for name, rule := range another.FDependencies {
+		if prev, exist := f.FDependencies[name]; exist {
+			rule.ref.Value = prev.ref.Value.merge(rule.ref.Value)
+		}
+
+		f.FDependencies[name] = rule
+	}
+}
+
+func (r ArchV4Rule) merge(another ArchV4Rule) ArchV4Rule {
+	r.FMayDependOn = mergeRefList(r.FMayDependOn, another.FMayDependOn)
+	r.FMayNotDependOn = mergeRefList(r.FMayNotDependOn, another.FMayNotDependOn)
+	r.FCanUse = mergeRefList(r.FCanUse, another.FCanUse)
+	r.FCannotUse = mergeRefList(r.FCannotUse, another.FCannotUse)
+	r.FCanUseStd = mergeRefList(r.FCanUseStd, another.FCanUseStd)
+	r.FCannotUseStd = mergeRefList(r.FCannotUseStd, another.FCannotUseStd)
+	r.FAnyProjectDeps = mergeRef(r.FAnyProjectDeps, another.FAnyProjectDeps)
+	r.FAnyVendorDeps = mergeRef(r.FAnyVendorDeps, another.FAnyVendorDeps)
+	r.FDeepScan = mergeRef(r.FDeepScan, another.FDeepScan)
+	r.FSameCapture = mergeRef(r.FSameCapture, another.FSameCapture)
+	r.FAnyCapture = mergeRefList(r.FAnyCapture, another.FAnyCapture)
+	r.FSeverity = mergeRef(r.FSeverity, another.FSeverity)
+	r.FTests = r.FTests.merge(another.FTests)
+
+	tags := make(map[string]ArchV4AdditionalRule, len(r.FTags)+len(another.FTags))
+	for tag, rule := range r.FTags {
+		tags[tag] = rule
+	}
+
+	for tag, rule := range another.FTags {
+		tags[tag] = tags[tag].merge(rule)
+	}
+
+	r.FTags = tags
+
+	symbols := make(map[string]ArchV4SymbolRule, len(r.FSymbols)+len(another.FSymbols))
+	for name, rule := range r.FSymbols {
+		symbols[name] = rule
+	}
+
+	for name, rule := range another.FSymbols {
+		symbols[name] = symbols[name].merge(rule)
+	}
+
+	r.FSymbols = symbols
+
+	return r
+}
+
+func (r ArchV4SymbolRule) merge(another ArchV4SymbolRule) ArchV4SymbolRule {
+	r.FAllow = mergeRefList(r.FAllow, another.FAllow)
+	r.FDeny = mergeRefList(r.FDeny, another.FDeny)
+
+	return r
+}
+
+func (r ArchV4AdditionalRule) merge(another ArchV4AdditionalRule) ArchV4AdditionalRule {
+	r.FMayDependOn = mergeRefList(r.FMayDependOn, another.FMayDependOn)
+	r.FCanUse = mergeRefList(r.FCanUse, another.FCanUse)
+
+	return r
+}
+
+func mergeRef[T any](prev ref[T], next ref[T]) ref[T] {
+	if next.defined {
+		return next
+	}
+
+	return prev
+}
+
+// mergeRefList returns unique values from both lists, first definition
+// is kept, so reference will point to file that define value first
+func mergeRefList(prev []ref[string], next []ref[string]) []ref[string] {
+	exist := make(map[string]struct{}, len(prev)+len(next))
+	merged := make([]ref[string], 0, len(prev)+len(next))
+
+	for _, list := range [][]ref[string]{prev, next} {
+		for _, value := range list {
+			if _, found := exist[value.ref.Value]; found {
+				continue
+			}
+
+			exist[value.ref.Value] = struct{}{}
+			merged = append(merged, value)
+		}
+	}
+
+	return merged
+}
+
+func mergeRefMap[K comparable, V any](prev map[K]ref[V], next map[K]ref[V]) map[K]ref[V] {
+	merged := make(map[K]ref[V], len(prev)+len(next))
+
+	for key, value := range prev {
+		merged[key] = value
+	}
+
+	for key, value := range next {
+		merged[key] = value
+	}
+
+	return merged
+}
+
+func inIncludeStack(ctx *includeContext, filePath string) bool {
+	for _, stackFile := range ctx.stack {
+		if stackFile == filePath {
+			return true
+		}
+	}
+
+	return false
+}
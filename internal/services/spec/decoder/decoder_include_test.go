@@ -0,0 +1,189 @@
+package decoder
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fe3dback/go-arch-lint/internal/services/common/yaml/reference"
+	"github.com/fe3dback/go-arch-lint/internal/services/schema"
+)
+
+func writeTestFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	return dir
+}
+
+func TestDecoder_Include(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{
+		"base.yml": `
+include:
+  - vendors.yml
+components:
+  handler: { in: handlers }
+  model:   { in: models }
+commonComponents:
+  - model
+deps:
+  handler:
+    mayDependOn:
+      - model
+    canUse:
+      - yaml
+  model:
+    anyVendorDeps: true
+`,
+		"vendors.yml": `
+vendors:
+  yaml: { in: gopkg.in/yaml.v3 }
+commonVendors:
+  - yaml
+`,
+		"arch.yml": `
+version: 4
+include:
+  - base.yml
+  - vendors.yml
+components:
+  handler: { in: api/handlers }
+  service: { in: services }
+commonComponents:
+  - model
+deps:
+  handler:
+    mayDependOn:
+      - service
+    anyVendorDeps: true
+`,
+	})
+
+	archFile := filepath.Join(dir, "arch.yml")
+	document, notices, err := NewDecoder(reference.NewResolver(), schema.NewProvider()).Decode(archFile)
+	require.NoError(t, err)
+	require.Empty(t, notices)
+
+	components := document.Components()
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	assert.Equal(t, []string{"handler", "model", "service"}, names)
+
+	// current file take precedence
+	assert.Equal(t, "api/handlers", string(components["handler"].Value.RelativePaths()[0]))
+	assert.Equal(t, archFile, components["handler"].Reference.File)
+	assert.Equal(t, filepath.Join(dir, "base.yml"), components["model"].Reference.File)
+
+	vendors := document.Vendors()
+	assert.Equal(t, filepath.Join(dir, "vendors.yml"), vendors["yaml"].Reference.File)
+
+	common := document.CommonComponents()
+	require.Len(t, common, 1)
+	assert.Equal(t, filepath.Join(dir, "base.yml"), common[0].Reference.File)
+
+	rule := document.Dependencies()["handler"].Value
+	mayDependOn := make([]string, 0)
+	for _, dep := range rule.MayDependOn() {
+		mayDependOn = append(mayDependOn, dep.Value)
+	}
+
+	assert.Equal(t, []string{"model", "service"}, mayDependOn)
+	assert.Equal(t, filepath.Join(dir, "base.yml"), rule.MayDependOn()[0].Reference.File)
+	assert.Equal(t, archFile, rule.MayDependOn()[1].Reference.File)
+	assert.Equal(t, "yaml", rule.CanUse()[0].Value)
+	assert.True(t, rule.AnyVendorDeps().Value)
+
+	// quick fixes in lsp will edit file with component deps
+	assert.Equal(t, filepath.Join(dir, "base.yml"), document.Dependencies()["model"].Reference.File)
+
+	// visited depth-first, vendors.yml included only once
+	assert.Equal(t, []string{
+		filepath.Join(dir, "base.yml"),
+		filepath.Join(dir, "vendors.yml"),
+	}, document.IncludedFiles())
+}
+
+func TestDecoder_IncludeNotices(t *testing.T) {
+	tests := []struct {
+		name   string
+		files  map[string]string
+		notice string
+		file   string
+		line   int
+	}{
+		{
+			name: "not exist",
+			files: map[string]string{
+				"arch.yml": "version: 4\ninclude: [missing.yml]\n",
+			},
+			notice: "failed to read included file 'missing.yml'",
+			file:   "arch.yml",
+			line:   2,
+		},
+		{
+			name: "cycle",
+			files: map[string]string{
+				"arch.yml": "version: 4\ninclude: [a.yml]\n",
+				"a.yml":    "include: [b.yml]\n",
+				"b.yml":    "include: [a.yml]\n",
+			},
+			notice: "include cycle",
+			file:   "b.yml",
+			line:   1,
+		},
+		{
+			name: "not allowed field",
+			files: map[string]string{
+				"arch.yml": "version: 4\ninclude: [a.yml]\n",
+				"a.yml":    "workdir: internal\n",
+			},
+			notice: "failed to parse included file 'a.yml'",
+			file:   "arch.yml",
+			line:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeTestFiles(t, tt.files)
+			_, notices, err := NewDecoder(reference.NewResolver(), schema.NewProvider()).Decode(filepath.Join(dir, "arch.yml"))
+			require.NoError(t, err)
+			require.Len(t, notices, 1)
+
+			assert.Contains(t, notices[0].Notice.Error(), tt.notice)
+			assert.Equal(t, filepath.Join(dir, tt.file), notices[0].Ref.File)
+			assert.Equal(t, tt.line, notices[0].Ref.Line)
+		})
+	}
+}
+
+func TestDecoder_IncludeCycleWithSibling(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{
+		"arch.yml": "version: 4\ninclude: [a.yml]\n",
+		"a.yml":    "include: [arch.yml, b.yml]\n",
+		"b.yml":    "workdir: internal\n",
+	})
+
+	document, notices, err := NewDecoder(reference.NewResolver(), schema.NewProvider()).Decode(filepath.Join(dir, "arch.yml"))
+	require.NoError(t, err)
+	require.Len(t, notices, 2)
+
+	// includes after cycle is still checked and watched
+	assert.Contains(t, notices[0].Notice.Error(), "include cycle")
+	assert.Contains(t, notices[1].Notice.Error(), "failed to parse included file 'b.yml'")
+	assert.Equal(t, []string{
+		filepath.Join(dir, "a.yml"),
+		filepath.Join(dir, "b.yml"),
+	}, document.IncludedFiles())
+}
@@ -22,6 +22,7 @@ type (
 	Vendors      = map[VendorName]common.Referable[Vendor]
 	Components   = map[ComponentName]common.Referable[Component]
 	Dependencies = map[ComponentName]common.Referable[DependencyRule]
+	Exceptions   = []common.Referable[Exception]
 
 	Document interface {
 		// Version of spec (scheme of document)
@@ -61,6 +62,56 @@ type (
 
 		// Dependencies map between Components and DependencyRule`s
 		Dependencies() Dependencies
+
+		// Layers is ordered list (top to bottom) of Component names groups,
+		// components from each layer may depend on components from layers below
+		// examples:
+		//	- [handler, jobs]
+		//	- service
+		//	- repository
+		Layers() []common.Referable[[]string]
+
+		// Exceptions is temporary allowed (legalized) dependencies
+		// between components, each of them will expire at some date
+		Exceptions() Exceptions
+
+		// Severity is global level of check warnings
+		Severity() Severity
+
+		// Build is build constraints evaluation settings
+		// available since v4+ configs
+		Build() Build
+
+		// CannotUseStd is list of std packages globs, that can`t be imported to any
+		// component, unless component explicitly allow it in own CanUseStd list
+		// examples:
+		//	- unsafe
+		//	- reflect
+		CannotUseStd() []common.Referable[string]
+
+		// IncludedFiles is absolute paths of all files from 'include' (recursive),
+		// available since v4+ configs
+		IncludedFiles() []string
+	}
+
+	// Build define, how build constraints (//go:build lines and _GOOS/_GOARCH
+	// file suffixes) is evaluated, when not defined, all files is scanned
+	Build interface {
+		// Defined is true, when build constraints should be evaluated
+		Defined() bool
+
+		// GOOS target os, empty means current (or from GOOS env)
+		GOOS() common.Referable[string]
+
+		// GOARCH target arch, empty means current (or from GOARCH env)
+		GOARCH() common.Referable[string]
+
+		// Tags is build tags, that always set
+		Tags() []common.Referable[string]
+
+		// TagSets is several sets of tags (added to Tags), file is scanned
+		// when it match at least one set
+		TagSets() []common.Referable[[]string]
 	}
 
 	Options interface {
@@ -71,6 +122,19 @@ type (
 		// DeepScan turn on usage of advanced AST linter
 		// this is default behavior since v3+ configs
 		DeepScan() common.Referable[bool]
+
+		// DetectCycles turn on search of import cycles between components
+		// available since v4+ configs
+		DetectCycles() common.Referable[bool]
+
+		// StrictLayers allow layer depend only on next layer below,
+		// by default (relaxed) layer may depend on any layer below
+		// available since v4+ configs
+		StrictLayers() common.Referable[bool]
+
+		// IgnoreTests skip all test files (*_test.go) from analyse
+		// available since v4+ configs
+		IgnoreTests() common.Referable[bool]
 	}
 
 	Vendor interface {
@@ -79,24 +143,63 @@ type (
 		// 	- golang.org/x/mod/modfile
 		// 	- example.com/*/libs/**
 		ImportPaths() []models.Glob
+
+		// Modules is list of go.mod required modules paths (v4+), vendor
+		// contain all packages of this modules, but not nested modules
+		// example:
+		// 	- github.com/jackc/pgx/v5
+		// 	- github.com/aws/aws-sdk-go-v2/service/*
+		Modules() []models.Glob
+
+		// Version is semver constraint (v4+), that versions of vendor
+		// modules in go.mod 'require' list should satisfy
+		// example: ">=1.2.0, <2.0.0"
+		Version() common.Referable[string]
 	}
 
 	Component interface {
-		// RelativePaths can contain glob's
+		// RelativePaths can contain glob's and named wildcards (v4+)
 		// example:
 		// 	- internal/service/*/models/**
 		// 	- /
 		// 	- tests/**
+		// 	- domain/{ctx}/service
 		RelativePaths() []models.Glob
+
+		// Exports is public API packages of component (same format as RelativePaths),
+		// other components can import only this packages, when list is not empty
+		// example:
+		// 	- internal/billing
+		// 	- internal/billing/api/**
+		Exports() []models.Glob
 	}
 
 	DependencyRule interface {
 		// MayDependOn is list of Component names, that can be imported to described component
 		MayDependOn() []common.Referable[string]
 
+		// MayNotDependOn is list of Component names, that can`t be imported to described component
+		// deny list always take precedence over any allow rules (including anyProjectDeps and commonComponents)
+		MayNotDependOn() []common.Referable[string]
+
 		// CanUse is list of Vendor names, that can be imported to described component
 		CanUse() []common.Referable[string]
 
+		// CannotUse is list of Vendor names, that can`t be imported to described component
+		// deny list always take precedence over any allow rules (including anyVendorDeps and commonVendors)
+		CannotUse() []common.Referable[string]
+
+		// CanUseStd is list of std packages globs, that can be imported to described component,
+		// when not empty, all other std packages is not allowed
+		// examples:
+		//	- fmt
+		//	- encoding/**
+		CanUseStd() []common.Referable[string]
+
+		// CannotUseStd is list of std packages globs, that can`t be imported to described
+		// component, take precedence over CanUseStd
+		CannotUseStd() []common.Referable[string]
+
 		// AnyProjectDeps allow component to import any other local namespace packages
 		AnyProjectDeps() common.Referable[bool]
 
@@ -105,5 +208,105 @@ type (
 
 		// DeepScan overrides deepScan global option
 		DeepScan() common.Referable[bool]
+
+		// SameCapture allow import other components only from instance with same
+		// values of named wildcards, for example with component "domain/{ctx}/repository"
+		// "domain/billing/service" can import only "domain/billing/repository"
+		SameCapture() common.Referable[bool]
+
+		// AnyCapture is list of Component names, that is exception from SameCapture rule,
+		// it can be imported from any captured instance
+		AnyCapture() []common.Referable[string]
+
+		// Severity overrides global severity for warnings of this component
+		Severity() Severity
+
+		// Tests is additional rules for test files (*_test.go) of component,
+		// test files can import everything allowed for production code and
+		// also all components and vendors from this rules
+		Tests() AdditionalRule
+
+		// Tags is additional rules for files with build tag (from //go:build line),
+		// this files can import everything allowed for production code and
+		// also all components and vendors from rules of its tags
+		Tags() map[string]AdditionalRule
+
+		// Symbols is identifier rules for imported components and vendors,
+		// map key is Component or Vendor name, for example with rule
+		// "models: {deny: [*Entity]}" component code can use "models.UserDTO",
+		// but not "models.UserEntity"
+		Symbols() map[string]SymbolRule
+	}
+
+	// SymbolRule is allowed/denied exported identifiers of imported package
+	SymbolRule interface {
+		// Allow is list of identifier globs, that can be used from package,
+		// when not empty, all other identifiers are denied
+		Allow() []common.Referable[string]
+
+		// Deny is list of identifier globs, that can`t be used from package,
+		// take precedence over Allow
+		Deny() []common.Referable[string]
+	}
+
+	// AdditionalRule is extra allowed imports for some
+	// group of component files (tests, tagged files)
+	AdditionalRule interface {
+		// MayDependOn is list of Component names, that can be imported to files
+		MayDependOn() []common.Referable[string]
+
+		// CanUse is list of Vendor names, that can be imported to files
+		CanUse() []common.Referable[string]
+	}
+
+	// Severity is level of check warnings ("error", "warning" or "info"),
+	// each method returns empty string, when level is not defined
+	Severity interface {
+		// Default level for all warnings kinds
+		Default() common.Referable[string]
+
+		// Imports is level for not allowed project imports
+		Imports() common.Referable[string]
+
+		// Vendors is level for not allowed vendor imports
+		Vendors() common.Referable[string]
+
+		// DeepScan is level for not allowed injections
+		DeepScan() common.Referable[string]
+
+		// Cycles is level for import cycles between components
+		Cycles() common.Referable[string]
+
+		// NotMatched is level for files not attached to any component (global only)
+		NotMatched() common.Referable[string]
+
+		// ExpiredExceptions is level for imports allowed by expired exceptions
+		ExpiredExceptions() common.Referable[string]
+
+		// Std is level for not allowed std packages imports
+		Std() common.Referable[string]
+
+		// Symbols is level for not allowed identifiers of imported packages
+		Symbols() common.Referable[string]
+	}
+
+	Exception interface {
+		// Component is name of Component, that can temporary import Target
+		Component() common.Referable[string]
+
+		// Target is name of Component or Vendor
+		Target() common.Referable[string]
+
+		// Reason why this dependency is allowed
+		Reason() common.Referable[string]
+
+		// Owner is responsible for fixing (person or team)
+		Owner() common.Referable[string]
+
+		// Ticket is issue tracker reference
+		Ticket() common.Referable[string]
+
+		// Expires is last date (YYYY-MM-DD), when exception is active
+		Expires() common.Referable[string]
 	}
 )
@@ -0,0 +1,191 @@
+package editor
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/lsp"
+	"github.com/fe3dback/go-yaml/ast"
+	"github.com/fe3dback/go-yaml/parser"
+)
+
+const (
+	keyDeps        = "deps"
+	keyMayDependOn = "mayDependOn"
+	indent         = "  "
+)
+
+// Editor make small changes in arch file, with respect
+// to current user formatting and comments
+type Editor struct{}
+
+func NewEditor() *Editor {
+	return &Editor{}
+}
+
+// AddMayDependOn returns text edit for arch file, that will
+// allow component to depend on another component
+func (e *Editor) AddMayDependOn(archFilePath string, componentName string, dependOn string) (lsp.TextEdit, error) {
+	sourceCode, err := os.ReadFile(archFilePath)
+	if err != nil {
+		return lsp.TextEdit{}, fmt.Errorf("failed to read arch file: %w", err)
+	}
+
+	return addMayDependOn(string(sourceCode), componentName, dependOn)
+}
+
+func addMayDependOn(sourceCode string, componentName string, dependOn string) (lsp.TextEdit, error) {
+	file, err := parser.ParseBytes([]byte(sourceCode), 0)
+	if err != nil {
+		return lsp.TextEdit{}, fmt.Errorf("failed to parse arch file: %w", err)
+	}
+
+	if len(file.Docs) == 0 || file.Docs[0].Body == nil {
+		return lsp.TextEdit{}, fmt.Errorf("arch file is empty")
+	}
+
+	lines := strings.Split(sourceCode, "\n")
+	root := file.Docs[0].Body
+
+	deps, found := mappingValue(root, keyDeps)
+	if !found {
+		// append new section into end of file
+		text := fmt.Sprintf("%s:\n%s%s:\n%s%s%s:\n%s%s%s- %s\n",
+			keyDeps,
+			indent, componentName,
+			indent, indent, keyMayDependOn,
+			indent, indent, indent, dependOn,
+		)
+
+		if !strings.HasSuffix(sourceCode, "\n") {
+			text = "\n" + text
+		}
+
+		lastLine := len(lines) - 1
+		return insertAt(lastLine, len(lines[lastLine]), text), nil
+	}
+
+	component, found := mappingValue(deps, componentName)
+	if !found {
+		line, column, ok := firstKeyPosition(deps)
+		if !ok {
+			return lsp.TextEdit{}, fmt.Errorf("unsupported '%s' format", keyDeps)
+		}
+
+		prefix := strings.Repeat(" ", column)
+		text := fmt.Sprintf("%s%s:\n%s%s%s:\n%s%s%s- %s\n",
+			prefix, componentName,
+			prefix, indent, keyMayDependOn,
+			prefix, indent, indent, dependOn,
+		)
+
+		return insertAt(line, 0, text), nil
+	}
+
+	list, found := mappingValue(component, keyMayDependOn)
+	if !found {
+		line, column, ok := firstKeyPosition(component)
+		if !ok {
+			return lsp.TextEdit{}, fmt.Errorf("unsupported '%s.%s' format", keyDeps, componentName)
+		}
+
+		prefix := strings.Repeat(" ", column)
+		text := fmt.Sprintf("%s%s:\n%s%s- %s\n",
+			prefix, keyMayDependOn,
+			prefix, indent, dependOn,
+		)
+
+		return insertAt(line, 0, text), nil
+	}
+
+	sequence, ok := list.(*ast.SequenceNode)
+	if !ok || len(sequence.Values) == 0 {
+		return lsp.TextEdit{}, fmt.Errorf("unsupported '%s.%s.%s' format", keyDeps, componentName, keyMayDependOn)
+	}
+
+	lastPos := sequence.Values[len(sequence.Values)-1].GetToken().Position
+	line, column := lastPos.Line-1, lastPos.Column-1
+
+	if sequence.IsFlowStyle {
+		// [a, b] -> [a, b, c]
+		return insertAt(line, scalarEnd(lines[line], column), ", "+dependOn), nil
+	}
+
+	// - b
+	// - c
+	text := fmt.Sprintf("%s%s", lines[line][:column], dependOn)
+	if line+1 >= len(lines) {
+		// last line without line break
+		return insertAt(line, len(lines[line]), "\n"+text), nil
+	}
+
+	return insertAt(line+1, 0, text+"\n"), nil
+}
+
+func mappingValue(node ast.Node, key string) (ast.Node, bool) {
+	for _, value := range mappingValues(node) {
+		if value.Key.GetToken().Value == key {
+			return value.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+func mappingValues(node ast.Node) []*ast.MappingValueNode {
+	switch mapping := node.(type) {
+	case *ast.MappingNode:
+		if mapping.IsFlowStyle {
+			return nil
+		}
+
+		return mapping.Values
+	case *ast.MappingValueNode:
+		return []*ast.MappingValueNode{mapping}
+	default:
+		return nil
+	}
+}
+
+// firstKeyPosition returns zero based position of first
+// key in block mapping, new keys will be inserted before it
+func firstKeyPosition(node ast.Node) (int, int, bool) {
+	values := mappingValues(node)
+	if len(values) == 0 {
+		return 0, 0, false
+	}
+
+	pos := values[0].Key.GetToken().Position
+	return pos.Line - 1, pos.Column - 1, true
+}
+
+// scalarEnd find end of (maybe quoted) scalar in flow sequence
+func scalarEnd(line string, column int) int {
+	if column >= len(line) {
+		return len(line)
+	}
+
+	if quote := line[column]; quote == '"' || quote == '\'' {
+		if end := strings.IndexByte(line[column+1:], quote); end != -1 {
+			return column + 1 + end + 1
+		}
+
+		return len(line)
+	}
+
+	if end := strings.IndexAny(line[column:], ",] \t#"); end != -1 {
+		return column + end
+	}
+
+	return len(line)
+}
+
+func insertAt(line int, character int, text string) lsp.TextEdit {
+	pos := lsp.Position{Line: line, Character: character}
+
+	return lsp.TextEdit{
+		Range:   lsp.Range{Start: pos, End: pos},
+		NewText: text,
+	}
+}
@@ -0,0 +1,131 @@
+package editor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/lsp"
+	"github.com/stretchr/testify/assert"
+)
+
+func applyEdit(sourceCode string, edit lsp.TextEdit) string {
+	lines := strings.Split(sourceCode, "\n")
+	line := lines[edit.Range.Start.Line]
+	lines[edit.Range.Start.Line] = line[:edit.Range.Start.Character] + edit.NewText + line[edit.Range.Start.Character:]
+
+	return strings.Join(lines, "\n")
+}
+
+func Test_addMayDependOn(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name: "block list",
+			source: `version: 3
+deps:
+  a:
+    mayDependOn:
+      - b # comment
+    canUse:
+      - x
+`,
+			want: `version: 3
+deps:
+  a:
+    mayDependOn:
+      - b # comment
+      - c
+    canUse:
+      - x
+`,
+		},
+		{
+			name: "flow list",
+			source: `version: 3
+deps:
+  a:
+    mayDependOn: [ b, "d" ]
+`,
+			want: `version: 3
+deps:
+  a:
+    mayDependOn: [ b, "d", c ]
+`,
+		},
+		{
+			name: "without mayDependOn",
+			source: `version: 3
+deps:
+  a:
+    canUse:
+      - x
+`,
+			want: `version: 3
+deps:
+  a:
+    mayDependOn:
+      - c
+    canUse:
+      - x
+`,
+		},
+		{
+			name: "without component",
+			source: `version: 3
+deps:
+    b:
+        anyProjectDeps: true
+`,
+			want: `version: 3
+deps:
+    a:
+      mayDependOn:
+        - c
+    b:
+        anyProjectDeps: true
+`,
+		},
+		{
+			name: "without deps",
+			source: `version: 3
+components:
+  a: { in: a }`,
+			want: `version: 3
+components:
+  a: { in: a }
+deps:
+  a:
+    mayDependOn:
+      - c
+`,
+		},
+		{
+			name: "last line",
+			source: `deps:
+  a:
+    mayDependOn:
+      - b`,
+			want: `deps:
+  a:
+    mayDependOn:
+      - b
+      - c`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edit, err := addMayDependOn(tt.source, "a", "c")
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, applyEdit(tt.source, edit))
+		})
+	}
+}
+
+func Test_addMayDependOn_unsupported(t *testing.T) {
+	_, err := addMayDependOn("deps:\n  a: { mayDependOn: [ b ] }\n", "a", "c")
+	assert.Error(t, err)
+}
@@ -0,0 +1,117 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+type (
+	// versionConstraint is list of semver conditions, all of them
+	// should be satisfied, example: ">=1.2.0, <2.0.0"
+	versionConstraint []versionCondition
+
+	versionCondition struct {
+		operator string
+		version  string
+	}
+)
+
+var versionOperators = []string{">=", "<=", "!=", ">", "<", "="}
+
+// parseVersionConstraint parse conditions separated by comma or space,
+// supported operators: =, !=, >, >=, <, <=. Version without operator is
+// same as "=", "v" prefix is optional
+func parseVersionConstraint(constraint string) (versionConstraint, error) {
+	fields := strings.FieldsFunc(constraint, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+
+	result := make(versionConstraint, 0, len(fields))
+
+	// operator can be separated from version by space: ">= 1.2.0"
+	operator := ""
+	for _, field := range fields {
+		if operator == "" {
+			operator = "="
+
+			for _, knownOperator := range versionOperators {
+				if strings.HasPrefix(field, knownOperator) {
+					operator = knownOperator
+					field = strings.TrimPrefix(field, knownOperator)
+					break
+				}
+			}
+		}
+
+		if field == "" {
+			continue
+		}
+
+		version := canonicalVersion(field)
+		if !semver.IsValid(version) {
+			return nil, fmt.Errorf("invalid version '%s'", field)
+		}
+
+		result = append(result, versionCondition{
+			operator: operator,
+			version:  version,
+		})
+
+		operator = ""
+	}
+
+	if operator != "" {
+		return nil, fmt.Errorf("operator '%s' without version", operator)
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("empty version constraint")
+	}
+
+	return result, nil
+}
+
+// satisfied check that module version (from go.mod) match all conditions
+func (vc versionConstraint) satisfied(version string) bool {
+	version = canonicalVersion(version)
+	if !semver.IsValid(version) {
+		return false
+	}
+
+	for _, condition := range vc {
+		if !condition.satisfied(version) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vc versionCondition) satisfied(version string) bool {
+	cmp := semver.Compare(version, vc.version)
+
+	switch vc.operator {
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	default:
+		return cmp == 0
+	}
+}
+
+func canonicalVersion(version string) string {
+	if !strings.HasPrefix(version, "v") {
+		return "v" + version
+	}
+
+	return version
+}
@@ -0,0 +1,42 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_versionConstraint_satisfied(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		version    string
+		want       bool
+	}{
+		{name: "exact", constraint: "1.2.0", version: "v1.2.0", want: true},
+		{name: "exact not matched", constraint: "=v1.2.0", version: "v1.2.1", want: false},
+		{name: "range", constraint: ">=1.2.0, <2.0.0", version: "v1.9.3", want: true},
+		{name: "range upper", constraint: ">=1.2.0, <2.0.0", version: "v2.0.0", want: false},
+		{name: "space separated", constraint: ">= 1.2 < 2", version: "v1.5.0", want: true},
+		{name: "not equal", constraint: "!=1.3.0", version: "v1.3.0", want: false},
+		{name: "prerelease", constraint: ">1.0.0", version: "v1.0.1-rc.1", want: true},
+		{name: "incompatible", constraint: "<4", version: "v3.2.0+incompatible", want: true},
+		{name: "invalid version", constraint: ">1.0.0", version: "latest", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := parseVersionConstraint(tt.constraint)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, constraint.satisfied(tt.version))
+		})
+	}
+}
+
+func Test_parseVersionConstraint_invalid(t *testing.T) {
+	for _, constraint := range []string{"", ">=", "~1.2.0", ">=1.x", "1.0.0, <"} {
+		t.Run(constraint, func(t *testing.T) {
+			_, err := parseVersionConstraint(constraint)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
 )
 
@@ -13,6 +16,7 @@ type (
 		pathResolver pathResolver
 		document     spec.Document
 		projectDir   string
+		modules      common.Modules
 	}
 )
 
@@ -20,19 +24,21 @@ func newUtils(
 	pathResolver pathResolver,
 	document spec.Document,
 	projectDir string,
+	modules common.Modules,
 ) *utils {
 	return &utils{
 		projectDir:   projectDir,
 		pathResolver: pathResolver,
 		document:     document,
+		modules:      modules,
 	}
 }
 
 func (u *utils) assertGlobPathValid(localGlobPath string) error {
-	absPath := filepath.Join(u.projectDir, localGlobPath)
-	resolved, err := u.pathResolver.Resolve(absPath)
+	absPath := filepath.Join(u.projectDir, string(models.Glob(localGlobPath).WithoutCaptures()))
+	resolved, err := u.resolveGlobPath(localGlobPath)
 	if err != nil {
-		return fmt.Errorf("failed to resolv path: %w", err)
+		return err
 	}
 
 	if len(resolved) == 0 {
@@ -42,6 +48,17 @@ func (u *utils) assertGlobPathValid(localGlobPath string) error {
 	return u.assertDirectoriesValid(resolved...)
 }
 
+// resolveGlobPath returns absolute paths of all directories matched by local glob
+func (u *utils) resolveGlobPath(localGlobPath string) ([]string, error) {
+	absPath := filepath.Join(u.projectDir, string(models.Glob(localGlobPath).WithoutCaptures()))
+	resolved, err := u.pathResolver.Resolve(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolv path: %w", err)
+	}
+
+	return resolved, nil
+}
+
 func (u *utils) assertDirectoriesValid(paths ...string) error {
 	for _, path := range paths {
 		if _, err := os.Stat(path); os.IsNotExist(err) {
@@ -71,3 +88,32 @@ func (u *utils) assertKnownVendor(name string) error {
 
 	return fmt.Errorf("unknown vendor '%s'", name)
 }
+
+// matchedRequires returns modules from go.mod 'require' lists, matched by glob
+func (u *utils) matchedRequires(moduleGlob models.Glob) ([]common.Require, error) {
+	matched := make([]common.Require, 0)
+
+	for _, require := range u.modules.Requires() {
+		ok, err := moduleGlob.Match(require.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			matched = append(matched, require)
+		}
+	}
+
+	return matched, nil
+}
+
+// sortedTags returns tags names of deps rule in stable order
+func sortedTags(rules map[string]spec.AdditionalRule) []string {
+	tags := make([]string, 0, len(rules))
+	for tag := range rules {
+		tags = append(tags, tag)
+	}
+
+	sort.Strings(tags)
+	return tags
+}
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
 )
 
@@ -17,10 +18,10 @@ func NewValidator(
 	}
 }
 
-func (v *Validator) Validate(doc spec.Document, projectDir string) []arch.Notice {
+func (v *Validator) Validate(doc spec.Document, projectDir string, modules common.Modules) []arch.Notice {
 	notices := make([]arch.Notice, 0)
 
-	utils := newUtils(v.pathResolver, doc, projectDir)
+	utils := newUtils(v.pathResolver, doc, projectDir, modules)
 	validators := []validator{
 		newValidatorCommonComponents(utils),
 		newValidatorCommonVendors(utils),
@@ -28,7 +29,11 @@ func (v *Validator) Validate(doc spec.Document, projectDir string) []arch.Notice
 		newValidatorDeps(utils),
 		newValidatorDepsComponents(utils),
 		newValidatorDepsVendors(utils),
+		newValidatorExceptions(utils),
 		newValidatorExcludeFiles(),
+		newValidatorLayers(utils),
+		newValidatorStd(),
+		newValidatorSymbols(utils),
 		newValidatorVendors(utils),
 		newValidatorVersion(),
 		newValidatorWorkDir(utils),
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path"
 
+	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
 )
@@ -43,8 +44,74 @@ func (v *validatorComponents) Validate(doc spec.Document) []arch.Notice {
 					Ref:    component.Reference,
 				})
 			}
+
+			if err := v.assertUniqueCaptures(componentIn); err != nil {
+				notices = append(notices, arch.Notice{
+					Notice: err,
+					Ref:    component.Reference,
+				})
+			}
+		}
+
+		for _, export := range component.Value.Exports() {
+			if err := v.assertExportValid(doc, component.Value, export); err != nil {
+				notices = append(notices, arch.Notice{
+					Notice: err,
+					Ref:    component.Reference,
+				})
+			}
 		}
 	}
 
 	return notices
 }
+
+// exported packages should exist and be part of component
+func (v *validatorComponents) assertExportValid(doc spec.Document, component spec.Component, export models.Glob) error {
+	localPath := func(glob models.Glob) string {
+		return path.Clean(fmt.Sprintf("%s/%s", doc.WorkingDirectory().Value, string(glob)))
+	}
+
+	if err := v.utils.assertGlobPathValid(localPath(export)); err != nil {
+		return fmt.Errorf("invalid export '%s': %w", export, err)
+	}
+
+	componentPaths := make(map[string]bool)
+	for _, componentIn := range component.RelativePaths() {
+		resolved, err := v.utils.resolveGlobPath(localPath(componentIn))
+		if err != nil {
+			return fmt.Errorf("failed to resolve component path '%s': %w", componentIn, err)
+		}
+
+		for _, resolvedPath := range resolved {
+			componentPaths[path.Clean(resolvedPath)] = true
+		}
+	}
+
+	exported, err := v.utils.resolveGlobPath(localPath(export))
+	if err != nil {
+		return fmt.Errorf("failed to resolve export '%s': %w", export, err)
+	}
+
+	for _, exportedPath := range exported {
+		if !componentPaths[path.Clean(exportedPath)] {
+			return fmt.Errorf("export '%s' is not part of component (directory '%s' not matched by component 'in')", export, exportedPath)
+		}
+	}
+
+	return nil
+}
+
+func (v *validatorComponents) assertUniqueCaptures(glob models.Glob) error {
+	exist := make(map[string]bool)
+
+	for _, name := range glob.CaptureNames() {
+		if exist[name] {
+			return fmt.Errorf("named wildcard '{%s}' used twice in '%s'", name, glob)
+		}
+
+		exist[name] = true
+	}
+
+	return nil
+}
@@ -44,6 +44,13 @@ func (v *validatorDeps) Validate(doc spec.Document) []arch.Notice {
 			})
 		}
 
+		if notMatched := rule.Value.Severity().NotMatched(); notMatched.Value != "" {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("'notMatched' severity can be defined only in global severity (not matched files not belong to any component)"),
+				Ref:    notMatched.Reference,
+			})
+		}
+
 		if len(rule.Value.MayDependOn()) == 0 && len(rule.Value.CanUse()) == 0 {
 			if rule.Value.AnyProjectDeps().Value {
 				continue
@@ -53,6 +60,16 @@ func (v *validatorDeps) Validate(doc spec.Document) []arch.Notice {
 				continue
 			}
 
+			// deny only rule, useful for restrict common components/vendors
+			if len(rule.Value.MayNotDependOn()) > 0 || len(rule.Value.CannotUse()) > 0 {
+				continue
+			}
+
+			// only tests, tags, std or symbol rules, production code use common components/vendors
+			if hasAdditionalRules(rule.Value) || hasStdRules(rule.Value) || len(rule.Value.Symbols()) > 0 {
+				continue
+			}
+
 			notices = append(notices, arch.Notice{
 				Notice: fmt.Errorf("should have ref in 'mayDependOn'/'canUse' or at least one flag of ['anyProjectDeps', 'anyVendorDeps']"),
 				Ref:    rule.Reference,
@@ -62,3 +79,22 @@ func (v *validatorDeps) Validate(doc spec.Document) []arch.Notice {
 
 	return notices
 }
+
+func hasAdditionalRules(rule spec.DependencyRule) bool {
+	rules := []spec.AdditionalRule{rule.Tests()}
+	for _, tagRule := range rule.Tags() {
+		rules = append(rules, tagRule)
+	}
+
+	for _, additional := range rules {
+		if len(additional.MayDependOn()) > 0 || len(additional.CanUse()) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasStdRules(rule spec.DependencyRule) bool {
+	return len(rule.CanUseStd()) > 0 || len(rule.CannotUseStd()) > 0
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
 )
 
@@ -23,25 +24,57 @@ func (v *validatorDepsComponents) Validate(doc spec.Document) []arch.Notice {
 	notices := make([]arch.Notice, 0)
 
 	for name, rule := range doc.Dependencies() {
-		existComponents := make(map[string]bool)
+		notices = append(notices, v.validateList(name, rule.Value.MayDependOn())...)
+		notices = append(notices, v.validateList(name, rule.Value.MayNotDependOn())...)
+		notices = append(notices, v.validateList(name, rule.Value.AnyCapture())...)
+		notices = append(notices, v.validateList(name, rule.Value.Tests().MayDependOn())...)
+		for _, tag := range sortedTags(rule.Value.Tags()) {
+			notices = append(notices, v.validateList(name, rule.Value.Tags()[tag].MayDependOn())...)
+		}
 
+		allowed := make(map[string]bool)
 		for _, componentName := range rule.Value.MayDependOn() {
-			if _, ok := existComponents[componentName.Value]; ok {
-				notices = append(notices, arch.Notice{
-					Notice: fmt.Errorf("component '%s' dublicated in '%s' deps", componentName.Value, name),
-					Ref:    componentName.Reference,
-				})
-			}
+			allowed[componentName.Value] = true
+		}
 
-			if err := v.utils.assertKnownComponent(componentName.Value); err != nil {
-				notices = append(notices, arch.Notice{
-					Notice: err,
-					Ref:    componentName.Reference,
-				})
+		for _, componentName := range rule.Value.MayNotDependOn() {
+			if !allowed[componentName.Value] {
+				continue
 			}
 
-			existComponents[componentName.Value] = true
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("component '%s' in '%s' deps is both in 'mayDependOn' and 'mayNotDependOn' (likely this is miss configuration)",
+					componentName.Value,
+					name,
+				),
+				Ref: componentName.Reference,
+			})
+		}
+	}
+
+	return notices
+}
+
+func (v *validatorDepsComponents) validateList(name string, list []common.Referable[string]) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+	existComponents := make(map[string]bool)
+
+	for _, componentName := range list {
+		if _, ok := existComponents[componentName.Value]; ok {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("component '%s' dublicated in '%s' deps", componentName.Value, name),
+				Ref:    componentName.Reference,
+			})
 		}
+
+		if err := v.utils.assertKnownComponent(componentName.Value); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: err,
+				Ref:    componentName.Reference,
+			})
+		}
+
+		existComponents[componentName.Value] = true
 	}
 
 	return notices
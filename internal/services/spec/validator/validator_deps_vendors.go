@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
 )
 
@@ -23,25 +24,56 @@ func (v *validatorDepsVendors) Validate(doc spec.Document) []arch.Notice {
 	notices := make([]arch.Notice, 0)
 
 	for name, rule := range doc.Dependencies() {
-		existVendors := make(map[string]bool)
+		notices = append(notices, v.validateList(name, rule.Value.CanUse())...)
+		notices = append(notices, v.validateList(name, rule.Value.CannotUse())...)
+		notices = append(notices, v.validateList(name, rule.Value.Tests().CanUse())...)
+		for _, tag := range sortedTags(rule.Value.Tags()) {
+			notices = append(notices, v.validateList(name, rule.Value.Tags()[tag].CanUse())...)
+		}
 
+		allowed := make(map[string]bool)
 		for _, vendorName := range rule.Value.CanUse() {
-			if _, ok := existVendors[vendorName.Value]; ok {
-				notices = append(notices, arch.Notice{
-					Notice: fmt.Errorf("vendor '%s' dublicated in '%s' deps", vendorName.Value, name),
-					Ref:    vendorName.Reference,
-				})
-			}
+			allowed[vendorName.Value] = true
+		}
 
-			if err := v.utils.assertKnownVendor(vendorName.Value); err != nil {
-				notices = append(notices, arch.Notice{
-					Notice: err,
-					Ref:    vendorName.Reference,
-				})
+		for _, vendorName := range rule.Value.CannotUse() {
+			if !allowed[vendorName.Value] {
+				continue
 			}
 
-			existVendors[vendorName.Value] = true
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("vendor '%s' in '%s' deps is both in 'canUse' and 'cannotUse' (likely this is miss configuration)",
+					vendorName.Value,
+					name,
+				),
+				Ref: vendorName.Reference,
+			})
+		}
+	}
+
+	return notices
+}
+
+func (v *validatorDepsVendors) validateList(name string, list []common.Referable[string]) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+	existVendors := make(map[string]bool)
+
+	for _, vendorName := range list {
+		if _, ok := existVendors[vendorName.Value]; ok {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("vendor '%s' dublicated in '%s' deps", vendorName.Value, name),
+				Ref:    vendorName.Reference,
+			})
 		}
+
+		if err := v.utils.assertKnownVendor(vendorName.Value); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: err,
+				Ref:    vendorName.Reference,
+			})
+		}
+
+		existVendors[vendorName.Value] = true
 	}
 
 	return notices
@@ -0,0 +1,54 @@
+package validator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type validatorExceptions struct {
+	utils *utils
+}
+
+func newValidatorExceptions(
+	utils *utils,
+) *validatorExceptions {
+	return &validatorExceptions{
+		utils: utils,
+	}
+}
+
+func (v *validatorExceptions) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	for _, exception := range doc.Exceptions() {
+		component := exception.Value.Component()
+		if err := v.utils.assertKnownComponent(component.Value); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: err,
+				Ref:    component.Reference,
+			})
+		}
+
+		target := exception.Value.Target()
+		if v.utils.assertKnownComponent(target.Value) != nil && v.utils.assertKnownVendor(target.Value) != nil {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("unknown exception target '%s', should be component or vendor name", target.Value),
+				Ref:    target.Reference,
+			})
+		}
+
+		expires := exception.Value.Expires()
+		if _, err := time.Parse(models.ExceptionDateLayout, expires.Value); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("invalid exception expiry date '%s', expected format YYYY-MM-DD", expires.Value),
+				Ref:    expires.Reference,
+			})
+		}
+	}
+
+	return notices
+}
@@ -0,0 +1,59 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type validatorLayers struct {
+	utils *utils
+}
+
+func newValidatorLayers(
+	utils *utils,
+) *validatorLayers {
+	return &validatorLayers{
+		utils: utils,
+	}
+}
+
+func (v *validatorLayers) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+	existComponents := make(map[string]int)
+
+	for ind, layer := range doc.Layers() {
+		if len(layer.Value) == 0 {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("layer %d is empty", ind+1),
+				Ref:    layer.Reference,
+			})
+		}
+
+		for _, componentName := range layer.Value {
+			if err := v.utils.assertKnownComponent(componentName); err != nil {
+				notices = append(notices, arch.Notice{
+					Notice: err,
+					Ref:    layer.Reference,
+				})
+			}
+
+			if prevInd, ok := existComponents[componentName]; ok {
+				notices = append(notices, arch.Notice{
+					Notice: fmt.Errorf("component '%s' already defined in layer %d, component can be only in one layer",
+						componentName,
+						prevInd+1,
+					),
+					Ref: layer.Reference,
+				})
+
+				continue
+			}
+
+			existComponents[componentName] = ind
+		}
+	}
+
+	return notices
+}
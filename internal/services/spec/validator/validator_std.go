@@ -0,0 +1,75 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type validatorStd struct {
+}
+
+func newValidatorStd() *validatorStd {
+	return &validatorStd{}
+}
+
+func (v *validatorStd) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+	notices = append(notices, v.validateList("cannotUseStd", doc.CannotUseStd())...)
+
+	deps := doc.Dependencies()
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		rule := deps[name].Value
+		notices = append(notices, v.validateList(fmt.Sprintf("'%s' deps", name), rule.CanUseStd())...)
+		notices = append(notices, v.validateList(fmt.Sprintf("'%s' deps", name), rule.CannotUseStd())...)
+	}
+
+	return notices
+}
+
+func (v *validatorStd) validateList(place string, list []common.Referable[string]) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+	exist := make(map[string]bool)
+
+	for _, glob := range list {
+		if exist[glob.Value] {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("std package '%s' dublicated in %s", glob.Value, place),
+				Ref:    glob.Reference,
+			})
+		}
+
+		exist[glob.Value] = true
+
+		if _, err := models.Glob(glob.Value).Match(""); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("invalid std package glob '%s' in %s: %w", glob.Value, place, err),
+				Ref:    glob.Reference,
+			})
+
+			continue
+		}
+
+		// std import paths never have dot in first element
+		if strings.Contains(strings.Split(glob.Value, "/")[0], ".") {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("'%s' in %s is not std package (use vendors for third party libs)", glob.Value, place),
+				Ref:    glob.Reference,
+			})
+		}
+	}
+
+	return notices
+}
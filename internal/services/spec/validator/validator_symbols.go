@@ -0,0 +1,117 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type validatorSymbols struct {
+	utils *utils
+}
+
+func newValidatorSymbols(
+	utils *utils,
+) *validatorSymbols {
+	return &validatorSymbols{
+		utils: utils,
+	}
+}
+
+func (v *validatorSymbols) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	deps := doc.Dependencies()
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		rule := deps[name]
+		symbols := rule.Value.Symbols()
+
+		targets := make([]string, 0, len(symbols))
+		for target := range symbols {
+			targets = append(targets, target)
+		}
+
+		sort.Strings(targets)
+
+		for _, target := range targets {
+			notices = append(notices, v.validateRule(name, target, symbols[target], rule.Reference)...)
+		}
+	}
+
+	return notices
+}
+
+func (v *validatorSymbols) validateRule(
+	componentName string,
+	target string,
+	rule spec.SymbolRule,
+	ref common.Reference,
+) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	errComponent := v.utils.assertKnownComponent(target)
+	errVendor := v.utils.assertKnownVendor(target)
+
+	if errComponent != nil && errVendor != nil {
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("'%s' deps symbols: unknown component or vendor '%s'", componentName, target),
+			Ref:    ref,
+		})
+	}
+
+	if errComponent == nil && errVendor == nil {
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("'%s' deps symbols: '%s' is ambiguous, both component and vendor has this name", componentName, target),
+			Ref:    ref,
+		})
+	}
+
+	if len(rule.Allow()) == 0 && len(rule.Deny()) == 0 {
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("'%s' deps symbols: rule for '%s' should have at least one glob in 'allow' or 'deny'", componentName, target),
+			Ref:    ref,
+		})
+	}
+
+	place := fmt.Sprintf("'%s' deps symbols of '%s'", componentName, target)
+	notices = append(notices, v.validateList(place, rule.Allow())...)
+	notices = append(notices, v.validateList(place, rule.Deny())...)
+
+	return notices
+}
+
+func (v *validatorSymbols) validateList(place string, list []common.Referable[string]) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+	exist := make(map[string]bool)
+
+	for _, glob := range list {
+		if exist[glob.Value] {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("identifier '%s' dublicated in %s", glob.Value, place),
+				Ref:    glob.Reference,
+			})
+		}
+
+		exist[glob.Value] = true
+
+		if _, err := models.Glob(glob.Value).Match(""); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("invalid identifier glob '%s' in %s: %w", glob.Value, place, err),
+				Ref:    glob.Reference,
+			})
+		}
+	}
+
+	return notices
+}
@@ -1,7 +1,13 @@
 package validator
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
 )
 
@@ -17,6 +23,153 @@ func newValidatorVendors(
 	}
 }
 
-func (v *validatorVendors) Validate(_ spec.Document) []arch.Notice {
-	return make([]arch.Notice, 0)
+func (v *validatorVendors) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	vendors := doc.Vendors()
+	names := make([]string, 0, len(vendors))
+	for name := range vendors {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		notices = append(notices, v.validateVendor(name, vendors[name])...)
+
+		if doc.Version().Value >= 4 {
+			notices = append(notices, v.validateImportPaths(name, vendors[name])...)
+		}
+	}
+
+	return notices
+}
+
+func (v *validatorVendors) validateVendor(name string, vendor common.Referable[spec.Vendor]) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+	modules := vendor.Value.Modules()
+	version := vendor.Value.Version()
+
+	if len(vendor.Value.ImportPaths()) == 0 && len(modules) == 0 {
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("vendor '%s' should define import paths 'in' or go.mod 'module'", name),
+			Ref:    vendor.Reference,
+		})
+	}
+
+	var constraint versionConstraint
+	if version.Value != "" {
+		if len(modules) == 0 {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("vendor '%s' version constraint can be used only with 'module'", name),
+				Ref:    version.Reference,
+			})
+		}
+
+		parsed, err := parseVersionConstraint(version.Value)
+		if err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("vendor '%s' invalid version constraint '%s': %w", name, version.Value, err),
+				Ref:    version.Reference,
+			})
+		}
+
+		constraint = parsed
+	}
+
+	for _, module := range modules {
+		requires, err := v.utils.matchedRequires(module)
+		if err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("vendor '%s' invalid module glob '%s': %w", name, module, err),
+				Ref:    vendor.Reference,
+			})
+
+			continue
+		}
+
+		if len(requires) == 0 {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("vendor '%s' module '%s' not found in go.mod require list", name, module),
+				Ref:    vendor.Reference,
+			})
+
+			continue
+		}
+
+		if constraint == nil {
+			continue
+		}
+
+		for _, require := range requires {
+			if constraint.satisfied(require.Version) {
+				continue
+			}
+
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("vendor '%s' module '%s' required with version '%s', that not satisfy constraint '%s'",
+					name,
+					require.Path,
+					require.Version,
+					version.Value,
+				),
+				Ref: version.Reference,
+			})
+		}
+	}
+
+	return notices
+}
+
+// validateImportPaths check that vendor 'in' globs (v4+) match packages of
+// any module from go.mod 'require' list, std globs like "net/**" is skipped
+func (v *validatorVendors) validateImportPaths(name string, vendor common.Referable[spec.Vendor]) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+	requires := v.utils.modules.Requires()
+
+	for _, glob := range vendor.Value.ImportPaths() {
+		if !isVendorGlob(glob) || isGlobMatchAnyModule(glob, requires) {
+			continue
+		}
+
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("vendor '%s' glob '%s' not match any module from go.mod require list", name, glob),
+			Ref:    vendor.Reference,
+		})
+	}
+
+	return notices
+}
+
+// isVendorGlob is false for std globs like "net/**",
+// std packages not have domain in first path element
+func isVendorGlob(glob models.Glob) bool {
+	first, _, _ := strings.Cut(string(glob), "/")
+	return strings.Contains(first, ".")
+}
+
+// isGlobMatchAnyModule check that glob can match packages of any required
+// module: glob match module itself ("example.com/*"), or static glob part
+// and module path is prefixes of each other ("example.com/lib/**")
+func isGlobMatchAnyModule(glob models.Glob, requires []common.Require) bool {
+	static, hasWildcards := string(glob), false
+	if index := strings.IndexAny(static, "*?[{"); index >= 0 {
+		static, hasWildcards = static[:index], true
+	}
+
+	for _, require := range requires {
+		if matched, err := glob.Match(require.Path); err == nil && matched {
+			return true
+		}
+
+		if static == require.Path || strings.HasPrefix(static, require.Path+"/") {
+			return true
+		}
+
+		if hasWildcards && strings.HasPrefix(require.Path, static) {
+			return true
+		}
+	}
+
+	return false
 }
@@ -10,12 +10,21 @@ import (
 //go:embed view_check.gohtml
 var viewCheck []byte
 
+//go:embed view_check_watch.gohtml
+var viewCheckWatch []byte
+
 //go:embed view_error.gohtml
 var viewError []byte
 
+//go:embed view_explain.gohtml
+var viewExplain []byte
+
 //go:embed view_graph.gohtml
 var viewGraph []byte
 
+//go:embed view_init.gohtml
+var viewInit []byte
+
 //go:embed view_mapping.gohtml
 var viewMapping []byte
 
@@ -25,16 +34,23 @@ var viewSchema []byte
 //go:embed view_self_inspect.gohtml
 var viewSelfInspect []byte
 
+//go:embed view_unused.gohtml
+var viewUnused []byte
+
 //go:embed view_version.gohtml
 var viewVersion []byte
 
 var Templates = map[string]string{
 	tpl(models.CmdCheckOut{}):       string(viewCheck),
+	tpl(models.CmdCheckWatchOut{}):  string(viewCheckWatch),
 	tpl(models.CmdErrorOut{}):       string(viewError),
+	tpl(models.CmdExplainOut{}):     string(viewExplain),
 	tpl(models.CmdGraphOut{}):       string(viewGraph),
+	tpl(models.CmdInitOut{}):        string(viewInit),
 	tpl(models.CmdMappingOut{}):     string(viewMapping),
 	tpl(models.CmdSchemaOut{}):      string(viewSchema),
 	tpl(models.CmdSelfInspectOut{}): string(viewSelfInspect),
+	tpl(models.CmdUnusedOut{}):      string(viewUnused),
 	tpl(models.CmdVersionOut{}):     string(viewVersion),
 }
 
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"os"
@@ -21,7 +22,7 @@ func TestCLI(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	ts.Setup = func(_ string) error {
+	ts.Setup = func(workDir string) error {
 		_, testFileName, _, ok := runtime.Caller(0)
 		if !ok {
 			return fmt.Errorf("failed get real working directory from caller")
@@ -32,9 +33,29 @@ func TestCLI(t *testing.T) {
 			return fmt.Errorf("failed change 'ROOTDIR' to caller working directory: %w", err)
 		}
 
+		// temporary test directory, for files written by linter (baselines)
+		if err := os.Setenv("WORKDIR", workDir); err != nil {
+			return fmt.Errorf("failed set 'WORKDIR' to test working directory: %w", err)
+		}
+
 		return nil
 	}
 
-	ts.Commands[binaryName] = cmdtest.InProcessProgram(binaryName, run)
+	ts.Commands[binaryName] = scrubWorkDir(cmdtest.InProcessProgram(binaryName, run))
 	ts.Run(t, *update)
 }
+
+// scrubWorkDir replace temporary test directory in output with ${WORKDIR},
+// same as cmdtest do with ${ROOTDIR}
+func scrubWorkDir(cmd cmdtest.CommandFunc) cmdtest.CommandFunc {
+	return func(args []string, inputFile string) ([]byte, error) {
+		out, err := cmd(args, inputFile)
+
+		workDir := os.Getenv("WORKDIR")
+		if workDir != "" {
+			out = bytes.ReplaceAll(out, []byte(workDir), []byte("${WORKDIR}"))
+		}
+
+		return out, err
+	}
+}
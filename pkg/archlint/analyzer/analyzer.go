@@ -0,0 +1,348 @@
+// Package analyzer provide go/analysis Analyzer, that report component
+// dependency violations on import specs. It can be used with any analysis
+// driver (singlechecker, multichecker, go vet -vettool, golangci-lint plugin).
+package analyzer
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/checker"
+	"github.com/fe3dback/go-arch-lint/internal/services/checker/deepscan"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/path"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/yaml/reference"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/holder"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/info"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/resolver"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/scanner"
+	"github.com/fe3dback/go-arch-lint/internal/services/schema"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec/assembler"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec/decoder"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec/validator"
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	Name = "goarchlint"
+
+	categoryDependency = "deps"
+	categoryExpired    = "expired-exception"
+	categoryStd        = "std"
+	categorySymbols    = "symbols"
+)
+
+// Analyzer with default settings, project is detected
+// by nearest go.mod file of analyzed package
+var Analyzer = New(Settings{})
+
+type (
+	Settings struct {
+		// ProjectPath is directory with go.mod (or go.work) and arch file, when
+		// empty, nearest go.work or go.mod of analyzed package is used
+		ProjectPath string `json:"project-path"`
+
+		// ArchFile is arch file path relative to project directory
+		ArchFile string `json:"arch-file"`
+
+		// FailOn is minimal severity of reported warnings, by default
+		// only errors is reported (warnings and info is skipped)
+		FailOn string `json:"fail-on"`
+	}
+
+	runner struct {
+		settings Settings
+
+		mux      sync.Mutex
+		scanner  *scanner.Scanner
+		projects map[string]*project
+	}
+
+	// project is arch spec and files mapping, loaded
+	// once and shared between all analyzed packages
+	project struct {
+		spec       arch.Spec
+		components map[string]arch.Component
+		files      map[string]models.FileHold // abs file path -> component hold
+		err        error
+	}
+)
+
+func New(settings Settings) *analysis.Analyzer {
+	if settings.ArchFile == "" {
+		settings.ArchFile = models.DefaultArchFileName
+	}
+
+	if settings.FailOn == "" {
+		settings.FailOn = models.SeverityError
+	}
+
+	r := &runner{
+		settings: settings,
+		projects: map[string]*project{},
+	}
+
+	analyzer := &analysis.Analyzer{
+		Name: Name,
+		Doc:  "check that imports between project components are allowed by go-arch-lint config",
+		URL:  "https://github.com/fe3dback/go-arch-lint",
+		Run:  r.run,
+	}
+
+	analyzer.Flags.StringVar(&r.settings.ProjectPath, "project-path", r.settings.ProjectPath, "directory with go.mod and arch file (by default nearest go.mod of package)")
+	analyzer.Flags.StringVar(&r.settings.ArchFile, "arch-file", r.settings.ArchFile, "arch file path")
+	analyzer.Flags.StringVar(&r.settings.FailOn, "fail-on", r.settings.FailOn, "minimal severity of reported warnings (error, warning, info)")
+
+	return analyzer
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	if len(pass.Files) == 0 {
+		return nil, nil
+	}
+
+	prj, err := r.project(pass.Fset.File(pass.Files[0].Pos()).Name())
+	if err != nil {
+		return nil, err
+	}
+
+	if prj == nil {
+		// package outside of any go module
+		return nil, nil
+	}
+
+	// packages are analyzed independently, so undeclared
+	// module is reported once per component in every package
+	importsChecker := checker.NewImport(nil)
+	symbolsChecker := checker.NewDeepScan(nil, nil)
+
+	for _, file := range pass.Files {
+		filePath := pass.Fset.File(file.Pos()).Name()
+
+		hold, exist := prj.files[filePath]
+		if !exist {
+			// excluded, not matched or generated file
+			continue
+		}
+
+		component := prj.components[*hold.ComponentID]
+		projectFile := models.ProjectFile{
+			Path:         filePath,
+			Kind:         hold.File.Kind,
+			BuildTags:    hold.File.BuildTags,
+			Imports:      r.scanner.FileImports(pass.Fset, file, prj.spec.Modules),
+			Suppressions: r.scanner.FileSuppressions(pass.Fset, file),
+		}
+
+		result, err := importsChecker.CheckFile(prj.spec, component, projectFile, hold.Captures)
+		if err != nil {
+			return nil, err
+		}
+
+		symbolsResult, err := symbolsChecker.CheckFileSymbols(prj.spec, component, projectFile,
+			deepscan.FileSymbolUsages(pass.Fset, file, pass.TypesInfo),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Append(symbolsResult)
+
+		for _, warn := range result.DependencyWarnings {
+			if !models.SeverityAtLeast(warn.Severity, r.settings.FailOn) {
+				continue
+			}
+
+			message := fmt.Sprintf("component %s shouldn't depend on %s", warn.ComponentName, warn.Target())
+			if reason := warn.Reason(); reason != "" {
+				message = fmt.Sprintf("%s (%s)", message, reason)
+			}
+
+			reportImport(pass, file, warn.ResolvedImportName, categoryDependency, message)
+		}
+
+		for _, warn := range result.ExpiredWarnings {
+			if !models.SeverityAtLeast(warn.Severity, r.settings.FailOn) {
+				continue
+			}
+
+			reportImport(pass, file, warn.ResolvedImportName, categoryExpired,
+				fmt.Sprintf("component %s shouldn't depend on %s, exception expired at %s", warn.ComponentName, warn.ResolvedImportName, warn.Expires),
+			)
+		}
+
+		for _, warn := range result.StdWarnings {
+			if !models.SeverityAtLeast(warn.Severity, r.settings.FailOn) {
+				continue
+			}
+
+			reportImport(pass, file, warn.ResolvedImportName, categoryStd,
+				fmt.Sprintf("component %s shouldn't use std package %s", warn.ComponentName, warn.ResolvedImportName),
+			)
+		}
+
+		for _, warn := range result.SymbolWarnings {
+			if !models.SeverityAtLeast(warn.Severity, r.settings.FailOn) {
+				continue
+			}
+
+			reportSymbol(pass, file, warn.Reference, len(warn.Symbol), categorySymbols,
+				fmt.Sprintf("component %s shouldn't use %s (%s)", warn.ComponentName, warn.Symbol, warn.ResolvedImportName),
+			)
+		}
+	}
+
+	return nil, nil
+}
+
+func reportImport(pass *analysis.Pass, file *ast.File, importPath string, category string, message string) {
+	spec := importSpec(file, importPath)
+	if spec == nil {
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      spec.Path.Pos(),
+		End:      spec.Path.End(),
+		Category: category,
+		Message:  message,
+	})
+}
+
+func reportSymbol(pass *analysis.Pass, file *ast.File, ref common.Reference, length int, category string, message string) {
+	tokenFile := pass.Fset.File(file.Pos())
+	if !ref.Valid || ref.Line > tokenFile.LineCount() {
+		return
+	}
+
+	pos := tokenFile.LineStart(ref.Line) + token.Pos(ref.Column-1)
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      pos,
+		End:      pos + token.Pos(length),
+		Category: category,
+		Message:  message,
+	})
+}
+
+func (r *runner) project(filePath string) (*project, error) {
+	projectPath := r.settings.ProjectPath
+	if projectPath == "" {
+		projectPath = findModuleDirectory(filepath.Dir(filePath))
+		if projectPath == "" {
+			return nil, nil
+		}
+	}
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if prj, exist := r.projects[projectPath]; exist {
+		return prj, prj.err
+	}
+
+	prj := r.load(projectPath)
+	r.projects[projectPath] = prj
+
+	return prj, prj.err
+}
+
+func (r *runner) load(projectPath string) *project {
+	if r.scanner == nil {
+		r.scanner = scanner.NewScanner()
+	}
+
+	projectInfo, err := info.NewAssembler().ProjectInfo(projectPath, r.settings.ArchFile)
+	if err != nil {
+		return &project{err: fmt.Errorf("failed to assemble project info: %w", err)}
+	}
+
+	pathResolver := path.NewResolver()
+	spec, err := assembler.NewAssembler(
+		decoder.NewDecoder(reference.NewResolver(), schema.NewProvider()),
+		validator.NewValidator(pathResolver),
+		pathResolver,
+	).Assemble(projectInfo)
+	if err != nil {
+		return &project{err: fmt.Errorf("failed to assemble spec: %w", err)}
+	}
+
+	if len(spec.Integrity.DocumentNotices) > 0 {
+		notices := make([]string, 0, len(spec.Integrity.DocumentNotices))
+		for _, notice := range spec.Integrity.DocumentNotices {
+			notices = append(notices, fmt.Sprintf("%s: %s", notice.Ref, notice.Notice))
+		}
+
+		return &project{err: fmt.Errorf("arch file '%s' is invalid:\n%s",
+			projectInfo.GoArchFilePath,
+			strings.Join(notices, "\n"),
+		)}
+	}
+
+	holds, err := resolver.NewResolver(r.scanner, holder.NewHolder()).ProjectFiles(context.Background(), spec)
+	if err != nil {
+		return &project{err: fmt.Errorf("failed to resolve project files: %w", err)}
+	}
+
+	prj := &project{
+		spec:       spec,
+		components: make(map[string]arch.Component, len(spec.Components)),
+		files:      make(map[string]models.FileHold, len(holds)),
+	}
+
+	for _, cmp := range spec.Components {
+		prj.components[cmp.Name.Value] = cmp
+	}
+
+	for _, hold := range holds {
+		if hold.ComponentID != nil {
+			prj.files[hold.File.Path] = hold
+		}
+	}
+
+	return prj
+}
+
+// findModuleDirectory returns go.work directory (workspace mode),
+// or nearest go.mod directory, same as go tool
+func findModuleDirectory(directory string) string {
+	if workspace := findParentWith(directory, models.DefaultGoWorkFileName); workspace != "" {
+		return workspace
+	}
+
+	return findParentWith(directory, models.DefaultGoModFileName)
+}
+
+func findParentWith(directory string, fileName string) string {
+	for {
+		if _, err := os.Stat(filepath.Join(directory, fileName)); err == nil {
+			return directory
+		}
+
+		parent := filepath.Dir(directory)
+		if parent == directory {
+			return ""
+		}
+
+		directory = parent
+	}
+}
+
+func importSpec(file *ast.File, importPath string) *ast.ImportSpec {
+	for _, spec := range file.Imports {
+		if value, err := strconv.Unquote(spec.Path.Value); err == nil && value == importPath {
+			return spec
+		}
+	}
+
+	return nil
+}
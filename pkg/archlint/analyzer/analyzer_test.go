@@ -0,0 +1,22 @@
+package analyzer
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData()+"/project", New(Settings{}), "./...")
+}
+
+func TestAnalyzer_SymbolRules(t *testing.T) {
+	// api component deny "*Entity" identifiers of model package
+	results := analysistest.Run(t, analysistest.TestData()+"/project", New(Settings{}), "./internal/api")
+
+	for _, result := range results {
+		if result.Pass.TypesInfo == nil {
+			t.Fatalf("analyzer should be executed with types info")
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package api
+
+import "example.com/project/internal/model"
+
+const Version = "v1"
+
+func User(name string) model.User {
+	entity := model.UserEntity{Name: name} // want `component api shouldn't use model.UserEntity \(example.com/project/internal/model\)`
+	return model.User{Name: entity.Name}
+}
@@ -0,0 +1,9 @@
+package cmd
+
+import (
+	"example.com/project/internal/api"
+	"example.com/project/internal/store"
+)
+
+var _ = api.Version
+var _ = store.Find
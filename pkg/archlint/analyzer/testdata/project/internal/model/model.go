@@ -0,0 +1,10 @@
+package model
+
+type User struct {
+	Name string
+}
+
+type UserEntity struct {
+	ID   int64
+	Name string
+}
@@ -0,0 +1,13 @@
+package store
+
+import (
+	"fmt"
+
+	"example.com/project/internal/api" // want `component store shouldn't depend on example.com/project/internal/api`
+	"example.com/project/internal/model"
+)
+
+func Find() model.User {
+	fmt.Println(api.Version)
+	return model.User{}
+}
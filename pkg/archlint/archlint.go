@@ -0,0 +1,175 @@
+// Package archlint is public API for embedding go-arch-lint into other
+// go programs (bots, monorepo tools, tests), without running cli binary.
+//
+//	linter := archlint.New()
+//	result, err := linter.Check(ctx, archlint.Options{
+//		ProjectPath: "./",
+//		Config:      []byte("version: 3\n..."),
+//	})
+package archlint
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/checker"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/path"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/yaml/reference"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/holder"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/info"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/resolver"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/scanner"
+	"github.com/fe3dback/go-arch-lint/internal/services/render/code"
+	"github.com/fe3dback/go-arch-lint/internal/services/render/printer"
+	"github.com/fe3dback/go-arch-lint/internal/services/schema"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec/assembler"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec/decoder"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec/validator"
+	"github.com/logrusorgru/aurora/v3"
+)
+
+const DefaultArchFile = models.DefaultArchFileName
+
+type (
+	// Linter is safe for sequential reuse, but not for concurrent
+	// calls, create one Linter per goroutine instead
+	Linter struct {
+		projectInfoAssembler *info.Assembler
+		specAssembler        *assembler.Assembler
+		specChecker          *checker.CompositeChecker
+	}
+
+	Options struct {
+		// ProjectPath is directory with go.mod file, by default current directory
+		ProjectPath string
+
+		// Config is arch file source code (yaml). When empty,
+		// ArchFile will be read from project directory
+		Config []byte
+
+		// ArchFile is arch file path relative to project directory, by default
+		// DefaultArchFile. For in-memory Config it used only in notices positions
+		ArchFile string
+	}
+)
+
+func New() *Linter {
+	pathResolver := path.NewResolver()
+	codeRender := code.NewRender(printer.NewColorPrinter(aurora.NewAurora(false)))
+
+	// all checkers share one scanner (std packages is loaded once,
+	// parsed files is cached between checkers)
+	filesResolver := resolver.NewResolver(
+		scanner.NewScanner(),
+		holder.NewHolder(),
+	)
+
+	return &Linter{
+		projectInfoAssembler: info.NewAssembler(),
+		specAssembler: assembler.NewAssembler(
+			decoder.NewDecoder(reference.NewResolver(), schema.NewProvider()),
+			validator.NewValidator(pathResolver),
+			pathResolver,
+		),
+		specChecker: checker.NewCompositeChecker(
+			checker.NewImport(filesResolver),
+			checker.NewCycles(filesResolver),
+			checker.NewDeepScan(filesResolver, codeRender),
+		),
+	}
+}
+
+// Check will validate arch config and check project code with it.
+// Invalid config is not an error, it will be reported in Result.Notices,
+// error is returned only when linter can't run (no go.mod, ctx is done, etc..)
+//
+// ctx is checked while scanning project files and between checkers,
+// but already started deepscan packages loading is not interrupted
+func (l *Linter) Check(ctx context.Context, opts Options) (Result, error) {
+	if err := ctx.Err(); err != nil {
+		return Result{}, err
+	}
+
+	spec, err := l.assembleSpec(opts)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result := Result{
+		ModuleName: spec.ModuleName.Value,
+		Notices:    newNotices(spec.Integrity.DocumentNotices),
+	}
+
+	if len(spec.Integrity.DocumentNotices) > 0 {
+		return result, nil
+	}
+
+	if err = ctx.Err(); err != nil {
+		return Result{}, err
+	}
+
+	checkResult, err := l.specChecker.Check(ctx, spec)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to check project deps: %w", err)
+	}
+
+	if err = ctx.Err(); err != nil {
+		// result can be incomplete, when ctx canceled during check
+		return Result{}, err
+	}
+
+	result.fill(checkResult)
+	return result, nil
+}
+
+func (l *Linter) assembleSpec(opts Options) (arch.Spec, error) {
+	projectPath := opts.ProjectPath
+	if projectPath == "" {
+		projectPath = models.DefaultProjectPath
+	}
+
+	archFile := opts.ArchFile
+	if archFile == "" {
+		archFile = DefaultArchFile
+	}
+
+	if len(opts.Config) == 0 {
+		projectInfo, err := l.projectInfoAssembler.ProjectInfo(projectPath, archFile)
+		if err != nil {
+			return arch.Spec{}, fmt.Errorf("failed to assemble project info: %w", err)
+		}
+
+		spec, err := l.specAssembler.Assemble(projectInfo)
+		if err != nil {
+			return arch.Spec{}, fmt.Errorf("failed to assemble spec: %w", err)
+		}
+
+		return spec, nil
+	}
+
+	projectInfo, err := l.projectInfoAssembler.ModuleInfo(projectPath)
+	if err != nil {
+		return arch.Spec{}, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	projectInfo.GoArchFilePath = archFilePath(projectInfo, archFile)
+
+	spec, err := l.specAssembler.AssembleSource(projectInfo, opts.Config)
+	if err != nil {
+		return arch.Spec{}, fmt.Errorf("failed to assemble spec: %w", err)
+	}
+
+	return spec, nil
+}
+
+func archFilePath(prj common.Project, archFile string) string {
+	if filepath.IsAbs(archFile) {
+		return archFile
+	}
+
+	return filepath.Join(prj.Directory, archFile)
+}
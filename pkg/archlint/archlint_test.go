@@ -0,0 +1,144 @@
+package archlint
+
+import (
+	"context"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `
+version: 3
+allow:
+  depOnAnyVendor: false
+exclude:
+  - internal/excluded
+  - variadic
+  - cycles
+excludeFiles:
+  - "^.*_test\\.go$"
+components:
+  main:   { in: internal/. }
+  a:      { in: internal/a }
+  allowb: { in: internal/a/allowb }
+  b:      { in: internal/b }
+  c:      { in: internal/c/** }
+  d:      { in: internal/d/** }
+  e:      { in: internal/e }
+  common: { in: internal/common/** }
+  nc:     { in: internal/not_covered }
+commonComponents:
+  - common
+deps:
+  e:
+    anyVendorDeps: true
+    mayDependOn:
+      - d
+  allowb:
+    mayDependOn:
+      - b
+`
+
+func testProjectPath() string {
+	_, filename, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(filename), "..", "..", "test", "check", "project")
+}
+
+func TestLinter_Check(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        Options
+		passed      bool
+		interrupted bool
+		deps        []string
+		notices     int
+		notMatch    int
+	}{
+		{
+			name: "in memory config",
+			opts: Options{
+				ProjectPath: testProjectPath(),
+				Config:      []byte(testConfig),
+			},
+			interrupted: true,
+			deps: []string{
+				"c -> github.com/fe3dback/go-arch-lint/test/check/project/internal/a",
+			},
+		},
+		{
+			name: "arch file from disk",
+			opts: Options{
+				ProjectPath: testProjectPath(),
+				ArchFile:    "arch1_ok.yml",
+			},
+			passed: true,
+		},
+		{
+			name: "arch file with warnings",
+			opts: Options{
+				ProjectPath: testProjectPath(),
+				ArchFile:    "arch1_warnings.yml",
+			},
+			interrupted: true,
+			deps: []string{
+				"c -> github.com/fe3dback/go-arch-lint/test/check/project/internal/a",
+			},
+			notMatch: 3,
+		},
+		{
+			name: "invalid config",
+			opts: Options{
+				ProjectPath: testProjectPath(),
+				Config:      []byte("version: 3\ncomponents:\n  a: { in: internal/a }\ndeps:\n  unknown: { mayDependOn: [a] }\n"),
+			},
+			notices: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := New().Check(context.Background(), tt.opts)
+			require.NoError(t, err)
+
+			deps := make([]string, 0, len(result.Dependencies))
+			for _, warn := range result.Dependencies {
+				deps = append(deps, warn.Component+" -> "+warn.Import)
+				assert.NotZero(t, warn.Position.Line)
+			}
+
+			assert.Equal(t, "github.com/fe3dback/go-arch-lint/test/check/project", result.ModuleName)
+			assert.Equal(t, tt.passed, result.Passed())
+			assert.Equal(t, tt.interrupted, result.Interrupted)
+			assert.Len(t, result.Notices, tt.notices)
+			assert.Len(t, result.NotMatched, tt.notMatch)
+			assert.ElementsMatch(t, tt.deps, deps)
+		})
+	}
+}
+
+func TestLinter_CheckNoticePosition(t *testing.T) {
+	result, err := New().Check(context.Background(), Options{
+		ProjectPath: testProjectPath(),
+		Config:      []byte("version: 3\ncomponents:\n  a: { in: internal/a }\ndeps:\n  unknown: { mayDependOn: [a] }\n"),
+		ArchFile:    "virtual.yml",
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Notices, 1)
+
+	assert.Equal(t, filepath.Join(testProjectPath(), "virtual.yml"), result.Notices[0].Position.File)
+	assert.Equal(t, 5, result.Notices[0].Position.Line)
+}
+
+func TestLinter_CheckErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := New().Check(ctx, Options{ProjectPath: testProjectPath(), Config: []byte(testConfig)})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = New().Check(context.Background(), Options{ProjectPath: t.TempDir(), Config: []byte(testConfig)})
+	assert.ErrorContains(t, err, "go.mod")
+}
@@ -0,0 +1,289 @@
+package archlint
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+// Severity levels of warnings, by default all warnings is errors,
+// but it can be changed in arch config (v4+)
+const (
+	SeverityError   = models.SeverityError
+	SeverityWarning = models.SeverityWarning
+	SeverityInfo    = models.SeverityInfo
+)
+
+type (
+	Result struct {
+		ModuleName string
+
+		// Notices is arch config problems, when config is invalid,
+		// project code is not checked and all warnings lists are empty
+		Notices []Notice
+
+		Dependencies []DependencyWarning
+		NotMatched   []MatchWarning
+		DeepScan     []DeepScanWarning
+		Cycles       []CycleWarning
+		Expired      []ExpiredWarning
+		Std          []StdWarning
+		Symbols      []SymbolWarning
+
+		// Interrupted is true, when not all checks is done, because
+		// of errors in previous checks (deepscan is not run, when
+		// project have import errors). Fix errors and check again
+		Interrupted bool
+	}
+
+	// Position in file, Line and Column starts from 1,
+	// empty position (zero line) means that it is unknown
+	Position struct {
+		File   string
+		Line   int
+		Column int
+	}
+
+	Notice struct {
+		Message  string
+		Position Position
+	}
+
+	// DependencyWarning is import of package, that component may not depend on
+	DependencyWarning struct {
+		Severity  string
+		Component string
+		Import    string
+		Position  Position
+
+		// Module is set, when import is from go.mod
+		// module, that not described in any vendor
+		Module string
+
+		// NotExportedBy is set, when imported package of
+		// this component is not listed in its exports
+		NotExportedBy string
+	}
+
+	// MatchWarning is project file, that not attached to any component
+	MatchWarning struct {
+		Severity string
+		File     string
+	}
+
+	// DeepScanWarning is not allowed dependency injection into component method
+	DeepScanWarning struct {
+		Severity            string
+		Component           string
+		Method              string
+		DependencyComponent string
+		Dependency          string
+		Position            Position
+	}
+
+	// CycleWarning is group of components, that depend on each other
+	CycleWarning struct {
+		Severity   string
+		Components []string
+		Chain      []CycleStep
+	}
+
+	// ExpiredWarning is import, that was allowed by already expired exception
+	ExpiredWarning struct {
+		Severity  string
+		Component string
+		Import    string
+		Expires   string // 2024-12-31
+		Owner     string
+		Ticket    string
+		Position  Position
+	}
+
+	// StdWarning is import of std package, that not allowed for component
+	StdWarning struct {
+		Severity  string
+		Component string
+		Import    string
+		Position  Position
+	}
+
+	// SymbolWarning is usage of imported package identifier,
+	// that not allowed by symbols rule of component
+	SymbolWarning struct {
+		Severity  string
+		Component string
+		Target    string // component or vendor name
+		Import    string
+		Symbol    string // models.UserEntity
+		Position  Position
+	}
+
+	CycleStep struct {
+		Component string
+		DependOn  string
+		Import    string
+		Position  Position
+	}
+)
+
+// Passed is true, when config is valid and project not have any warnings
+func (r Result) Passed() bool {
+	return len(r.Notices) == 0 && r.WarningsCount() == 0
+}
+
+// FailedOn is true, when config is invalid or project have some warnings
+// with same or more important severity than level (same as check --fail-on)
+func (r Result) FailedOn(level string) bool {
+	if len(r.Notices) > 0 {
+		return true
+	}
+
+	severities := make([]string, 0, r.WarningsCount())
+	for _, warn := range r.Dependencies {
+		severities = append(severities, warn.Severity)
+	}
+	for _, warn := range r.NotMatched {
+		severities = append(severities, warn.Severity)
+	}
+	for _, warn := range r.DeepScan {
+		severities = append(severities, warn.Severity)
+	}
+	for _, warn := range r.Cycles {
+		severities = append(severities, warn.Severity)
+	}
+	for _, warn := range r.Expired {
+		severities = append(severities, warn.Severity)
+	}
+	for _, warn := range r.Std {
+		severities = append(severities, warn.Severity)
+	}
+	for _, warn := range r.Symbols {
+		severities = append(severities, warn.Severity)
+	}
+
+	for _, severity := range severities {
+		if models.SeverityAtLeast(severity, level) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Result) WarningsCount() int {
+	return len(r.Dependencies) + len(r.NotMatched) + len(r.DeepScan) + len(r.Cycles) + len(r.Expired) + len(r.Std) + len(r.Symbols)
+}
+
+func (r *Result) fill(result models.CheckResult) {
+	r.Interrupted = result.Interrupted
+
+	r.Dependencies = make([]DependencyWarning, 0, len(result.DependencyWarnings))
+	for _, warn := range result.DependencyWarnings {
+		r.Dependencies = append(r.Dependencies, DependencyWarning{
+			Severity:      warn.Severity,
+			Component:     warn.ComponentName,
+			Import:        warn.ResolvedImportName,
+			Position:      newPosition(warn.Reference),
+			Module:        warn.Module,
+			NotExportedBy: warn.NotExportedBy,
+		})
+	}
+
+	r.NotMatched = make([]MatchWarning, 0, len(result.MatchWarnings))
+	for _, warn := range result.MatchWarnings {
+		r.NotMatched = append(r.NotMatched, MatchWarning{
+			Severity: warn.Severity,
+			File:     warn.FileAbsolutePath,
+		})
+	}
+
+	r.DeepScan = make([]DeepScanWarning, 0, len(result.DeepscanWarnings))
+	for _, warn := range result.DeepscanWarnings {
+		r.DeepScan = append(r.DeepScan, DeepScanWarning{
+			Severity:            warn.Severity,
+			Component:           warn.Gate.ComponentName,
+			Method:              warn.Gate.MethodName,
+			DependencyComponent: warn.Dependency.ComponentName,
+			Dependency:          warn.Dependency.Name,
+			Position:            newPosition(warn.Dependency.Injection),
+		})
+	}
+
+	r.Cycles = make([]CycleWarning, 0, len(result.CycleWarnings))
+	for _, warn := range result.CycleWarnings {
+		chain := make([]CycleStep, 0, len(warn.Chain))
+		for _, step := range warn.Chain {
+			chain = append(chain, CycleStep{
+				Component: step.ComponentName,
+				DependOn:  step.DependOn,
+				Import:    step.ResolvedImportName,
+				Position:  newPosition(step.Reference),
+			})
+		}
+
+		r.Cycles = append(r.Cycles, CycleWarning{
+			Severity:   warn.Severity,
+			Components: warn.ComponentNames,
+			Chain:      chain,
+		})
+	}
+
+	r.Expired = make([]ExpiredWarning, 0, len(result.ExpiredWarnings))
+	for _, warn := range result.ExpiredWarnings {
+		r.Expired = append(r.Expired, ExpiredWarning{
+			Severity:  warn.Severity,
+			Component: warn.ComponentName,
+			Import:    warn.ResolvedImportName,
+			Expires:   warn.Expires,
+			Owner:     warn.Owner,
+			Ticket:    warn.Ticket,
+			Position:  newPosition(warn.Reference),
+		})
+	}
+
+	r.Std = make([]StdWarning, 0, len(result.StdWarnings))
+	for _, warn := range result.StdWarnings {
+		r.Std = append(r.Std, StdWarning{
+			Severity:  warn.Severity,
+			Component: warn.ComponentName,
+			Import:    warn.ResolvedImportName,
+			Position:  newPosition(warn.Reference),
+		})
+	}
+
+	r.Symbols = make([]SymbolWarning, 0, len(result.SymbolWarnings))
+	for _, warn := range result.SymbolWarnings {
+		r.Symbols = append(r.Symbols, SymbolWarning{
+			Severity:  warn.Severity,
+			Component: warn.ComponentName,
+			Target:    warn.Target,
+			Import:    warn.ResolvedImportName,
+			Symbol:    warn.Symbol,
+			Position:  newPosition(warn.Reference),
+		})
+	}
+}
+
+func newNotices(notices []arch.Notice) []Notice {
+	list := make([]Notice, 0, len(notices))
+	for _, notice := range notices {
+		list = append(list, Notice{
+			Message:  notice.Notice.Error(),
+			Position: newPosition(notice.Ref),
+		})
+	}
+
+	return list
+}
+
+func newPosition(ref common.Reference) Position {
+	if !ref.Valid {
+		return Position{}
+	}
+
+	return Position{
+		File:   ref.File,
+		Line:   ref.Line,
+		Column: ref.Column,
+	}
+}
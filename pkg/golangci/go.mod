@@ -0,0 +1,32 @@
+module github.com/fe3dback/go-arch-lint/pkg/golangci
+
+go 1.21
+
+// local development only, replace is ignored when plugin is required by
+// golangci-lint build, so root module should be released first (see README.md)
+replace github.com/fe3dback/go-arch-lint => ../../
+
+require (
+	github.com/fe3dback/go-arch-lint v1.12.0
+	github.com/golangci/plugin-module-register v0.1.1
+	github.com/stretchr/testify v1.8.4
+	golang.org/x/tools v0.18.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/fatih/color v1.13.0 // indirect
+	github.com/fe3dback/go-yaml v1.14.0 // indirect
+	github.com/mattn/go-colorable v0.1.9 // indirect
+	github.com/mattn/go-isatty v0.0.14 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/xeipuuv/gojsonpointer v0.0.0-20180127040702-4e3ac2762d5f // indirect
+	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415 // indirect
+	github.com/xeipuuv/gojsonschema v1.2.0 // indirect
+	golang.org/x/crypto v0.19.0 // indirect
+	golang.org/x/mod v0.15.0 // indirect
+	golang.org/x/sync v0.6.0 // indirect
+	golang.org/x/sys v0.17.0 // indirect
+	golang.org/x/xerrors v0.0.0-20220907171357-04be3eba64a2 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,49 @@
+// Package golangci is golangci-lint module plugin entry point, see
+// https://golangci-lint.run/plugins/module-plugins/
+//
+// It is separate go module, because plugin register
+// require newer go version than linter itself.
+package golangci
+
+import (
+	"github.com/fe3dback/go-arch-lint/pkg/archlint/analyzer"
+	"github.com/golangci/plugin-module-register/register"
+	"golang.org/x/tools/go/analysis"
+)
+
+func init() {
+	register.Plugin(analyzer.Name, New)
+}
+
+type Plugin struct {
+	settings analyzer.Settings
+}
+
+// New is called by golangci-lint with linter settings from config:
+//
+//	linters-settings:
+//	  custom:
+//	    goarchlint:
+//	      type: module
+//	      settings:
+//	        project-path: ./
+//	        arch-file: .go-arch-lint.yml
+func New(settings any) (register.LinterPlugin, error) {
+	decoded, err := register.DecodeSettings[analyzer.Settings](settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Plugin{settings: decoded}, nil
+}
+
+func (p *Plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
+	return []*analysis.Analyzer{
+		analyzer.New(p.settings),
+	}, nil
+}
+
+func (p *Plugin) GetLoadMode() string {
+	// symbol rules resolve package selectors with types info
+	return register.LoadModeTypesInfo
+}
@@ -0,0 +1,31 @@
+package golangci
+
+import (
+	"testing"
+
+	"github.com/golangci/plugin-module-register/register"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	newPlugin, err := register.GetPlugin("goarchlint")
+	require.NoError(t, err)
+
+	plugin, err := newPlugin(map[string]any{
+		"project-path": "./",
+		"arch-file":    "custom.yml",
+	})
+	require.NoError(t, err)
+
+	analyzers, err := plugin.BuildAnalyzers()
+	require.NoError(t, err)
+	require.Len(t, analyzers, 1)
+
+	assert.Equal(t, "goarchlint", analyzers[0].Name)
+	assert.Equal(t, "custom.yml", analyzers[0].Flags.Lookup("arch-file").Value.String())
+	assert.Equal(t, register.LoadModeTypesInfo, plugin.GetLoadMode())
+
+	_, err = newPlugin(map[string]any{"unknown": true})
+	assert.Error(t, err)
+}
@@ -0,0 +1,10 @@
+package app
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/baseline/internal/repository"
+	"github.com/fe3dback/go-arch-lint/test/check/baseline/internal/service"
+)
+
+func Run() {
+	_ = service.NewService(repository.Users{})
+}
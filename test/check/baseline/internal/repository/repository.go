@@ -0,0 +1,7 @@
+package repository
+
+type Users struct{}
+
+func (Users) Get() string {
+	return ""
+}
@@ -0,0 +1,15 @@
+package service
+
+type (
+	repo interface {
+		Get() string
+	}
+
+	Service struct {
+		repo repo
+	}
+)
+
+func NewService(repo repo) *Service {
+	return &Service{repo: repo}
+}
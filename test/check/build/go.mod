@@ -0,0 +1,5 @@
+module github.com/fe3dback/go-arch-lint/test/check/build
+
+go 1.17
+
+require github.com/testcontainers/testcontainers-go v0.26.0
@@ -0,0 +1,9 @@
+package app
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/build/internal/storage"
+)
+
+func Run() string {
+	return (&storage.Storage{}).Name()
+}
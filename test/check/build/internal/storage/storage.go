@@ -0,0 +1,7 @@
+package storage
+
+type Storage struct{}
+
+func (s *Storage) Name() string {
+	return "storage"
+}
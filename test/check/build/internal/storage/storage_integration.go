@@ -0,0 +1,9 @@
+//go:build integration
+
+package storage
+
+import (
+	"github.com/testcontainers/testcontainers-go"
+)
+
+var _ testcontainers.Container
@@ -0,0 +1,7 @@
+package storage
+
+import (
+	"golang.org/x/sys/windows"
+)
+
+var _ = windows.GetCurrentProcessId
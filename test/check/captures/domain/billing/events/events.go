@@ -0,0 +1,3 @@
+package events
+
+const Name = "billing"
@@ -0,0 +1,3 @@
+package repository
+
+const Name = "billing"
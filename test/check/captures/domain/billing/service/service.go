@@ -0,0 +1,9 @@
+package service
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/captures/domain/billing/repository"
+	"github.com/fe3dback/go-arch-lint/test/check/captures/domain/users/events"
+	usersRepository "github.com/fe3dback/go-arch-lint/test/check/captures/domain/users/repository"
+)
+
+var _ = []string{repository.Name, events.Name, usersRepository.Name}
@@ -0,0 +1,8 @@
+package service
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/captures/domain/users/events"
+	"github.com/fe3dback/go-arch-lint/test/check/captures/domain/users/repository"
+)
+
+var _ = []string{repository.Name, events.Name}
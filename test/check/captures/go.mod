@@ -0,0 +1,3 @@
+module github.com/fe3dback/go-arch-lint/test/check/captures
+
+go 1.13
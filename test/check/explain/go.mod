@@ -0,0 +1,5 @@
+module github.com/fe3dback/go-arch-lint/test/check/explain
+
+go 1.20
+
+require github.com/google/uuid v1.3.1
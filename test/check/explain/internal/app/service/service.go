@@ -0,0 +1,5 @@
+package service
+
+import "unsafe"
+
+var Size = unsafe.Sizeof(0)
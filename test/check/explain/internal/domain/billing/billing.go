@@ -0,0 +1,7 @@
+package billing
+
+import "github.com/fe3dback/go-arch-lint/test/check/explain/internal/domain/user"
+
+type Invoice struct {
+	Owner user.User
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/test/check/explain/internal/domain/billing"
+	"github.com/fe3dback/go-arch-lint/test/check/explain/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+func Describe(invoice billing.Invoice, owner user.User) string {
+	return strings.ToUpper(fmt.Sprintf("%s: %s", owner.ID, uuid.NewString()))
+}
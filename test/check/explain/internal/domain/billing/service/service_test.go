@@ -0,0 +1,11 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestDescribe(t *testing.T) {
+	_ = uuid.NewString()
+}
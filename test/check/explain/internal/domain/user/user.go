@@ -0,0 +1,7 @@
+package user
+
+import "github.com/google/uuid"
+
+type User struct {
+	ID uuid.UUID
+}
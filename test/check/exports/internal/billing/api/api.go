@@ -0,0 +1,5 @@
+package api
+
+type ChargeRequest struct {
+	Amount int
+}
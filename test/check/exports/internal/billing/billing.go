@@ -0,0 +1,7 @@
+package billing
+
+type Service struct{}
+
+func (s *Service) Charge(amount int) error {
+	return nil
+}
@@ -0,0 +1,7 @@
+package impl
+
+type Store struct{}
+
+func (s *Store) Save(_ int) error {
+	return nil
+}
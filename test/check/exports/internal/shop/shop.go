@@ -0,0 +1,16 @@
+package shop
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/exports/internal/billing"
+	"github.com/fe3dback/go-arch-lint/test/check/exports/internal/billing/api"
+	"github.com/fe3dback/go-arch-lint/test/check/exports/internal/billing/impl"
+)
+
+type Shop struct {
+	billing *billing.Service
+	store   *impl.Store
+}
+
+func (s *Shop) Buy(req api.ChargeRequest) error {
+	return s.billing.Charge(req.Amount)
+}
@@ -0,0 +1,10 @@
+module github.com/fe3dback/go-arch-lint/test/check/modules
+
+go 1.20
+
+require (
+	github.com/google/uuid v1.3.1
+	github.com/jackc/pgx v3.6.2+incompatible
+	github.com/jackc/pgx/v5 v5.5.0
+	github.com/sirupsen/logrus v1.9.3
+)
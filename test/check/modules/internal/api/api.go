@@ -0,0 +1,18 @@
+package api
+
+import (
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/writer"
+
+	"github.com/fe3dback/go-arch-lint/test/check/modules/internal/db"
+)
+
+type Handler struct {
+	ID     uuid.UUID
+	Conn   *db.Conn
+	Tx     pgx.Tx
+	Logger *logrus.Logger
+	Hook   *writer.Hook
+}
@@ -0,0 +1,10 @@
+package db
+
+import (
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Conn = pgx.Conn
+
+type UUID = pgtype.UUID
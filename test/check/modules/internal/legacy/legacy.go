@@ -0,0 +1,13 @@
+package legacy
+
+import (
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	v5 "github.com/jackc/pgx/v5"
+)
+
+type (
+	Conn  = pgx.Conn
+	Int4  = pgtype.Int4
+	ConnV = v5.Conn
+)
@@ -0,0 +1,5 @@
+package a
+
+import "github.com/fe3dback/go-arch-lint/test/check/project/cycles/b"
+
+var A = b.B
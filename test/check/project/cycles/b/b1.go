@@ -0,0 +1,5 @@
+package b
+
+import "github.com/fe3dback/go-arch-lint/test/check/project/cycles/c"
+
+var B = c.C
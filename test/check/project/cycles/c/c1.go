@@ -0,0 +1,5 @@
+package c
+
+import "github.com/fe3dback/go-arch-lint/test/check/project/cycles/a/sub"
+
+var C = sub.Sub
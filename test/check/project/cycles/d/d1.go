@@ -0,0 +1,5 @@
+package d
+
+import "github.com/fe3dback/go-arch-lint/test/check/project/cycles/a"
+
+var D = a.A
@@ -1,3 +1,13 @@
 module github.com/fe3dback/go-arch-lint/test/check/project
 
 go 1.13
+
+require (
+	github.com/example/a v1.0.0
+	github.com/example/b v1.0.0
+)
+
+replace (
+	github.com/example/a => ../stubs/a
+	github.com/example/b => ../stubs/b
+)
@@ -0,0 +1,3 @@
+module github.com/fe3dback/go-arch-lint/test/check/std
+
+go 1.17
@@ -0,0 +1,16 @@
+package codec
+
+import (
+	"encoding/json"
+	"reflect"
+	"unsafe" //go-arch-lint:ignore zero copy string conversion
+)
+
+func Kind(v interface{}) reflect.Kind {
+	return reflect.TypeOf(v).Kind()
+}
+
+func Encode(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	return *(*string)(unsafe.Pointer(&data)), err
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"errors"
+	"net/http"
+	"unsafe"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type User struct {
+	ID int
+}
+
+func (u User) Size() uintptr {
+	return unsafe.Sizeof(u)
+}
+
+func (u User) Status() int {
+	return http.StatusOK
+}
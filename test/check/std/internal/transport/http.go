@@ -0,0 +1,18 @@
+package transport
+
+import (
+	"database/sql"
+	"net/http"
+	"reflect"
+
+	"github.com/fe3dback/go-arch-lint/test/check/std/internal/domain"
+)
+
+type Handler struct {
+	db *sql.DB
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(domain.User{}.Status())
+	_ = reflect.TypeOf(h.db)
+}
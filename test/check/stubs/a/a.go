@@ -0,0 +1,4 @@
+// Package a is stub of vendor library, used by project fixture
+package a
+
+func LibraryA() {}
@@ -0,0 +1,3 @@
+module github.com/example/a
+
+go 1.13
@@ -0,0 +1,4 @@
+// Package b is stub of vendor library, used by project fixture
+package b
+
+func LibraryB() {}
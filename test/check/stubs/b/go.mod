@@ -0,0 +1,3 @@
+module github.com/example/b
+
+go 1.13
@@ -0,0 +1,13 @@
+package app
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/suppress/internal/repository"
+	"github.com/fe3dback/go-arch-lint/test/check/suppress/internal/service"
+)
+
+func Run() {
+	//go-arch-lint:ignore reviewed injection
+	_ = service.NewService(repository.Users{})
+
+	_ = service.NewLegacyService()
+}
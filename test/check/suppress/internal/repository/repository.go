@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/suppress/internal/models" //go-arch-lint:ignore allowed anyway
+)
+
+type Users struct{}
+
+func (Users) Get() models.User {
+	return models.User{}
+}
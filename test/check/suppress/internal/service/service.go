@@ -0,0 +1,25 @@
+package service
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/suppress/internal/models"
+	//go-arch-lint:ignore legacy code, will be removed in next release
+	"github.com/fe3dback/go-arch-lint/test/check/suppress/internal/repository"
+)
+
+type (
+	repo interface {
+		Get() models.User
+	}
+
+	Service struct {
+		repo repo
+	}
+)
+
+func NewService(repo repo) *Service {
+	return &Service{repo: repo}
+}
+
+func NewLegacyService() *Service {
+	return &Service{repo: repository.Users{}}
+}
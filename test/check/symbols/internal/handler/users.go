@@ -0,0 +1,28 @@
+package handler
+
+import (
+	entities "github.com/fe3dback/go-arch-lint/test/check/symbols/internal/models"
+	"github.com/fe3dback/go-arch-lint/test/check/symbols/internal/repository"
+)
+
+type Handler struct {
+	repository *repository.Repository
+}
+
+func NewHandler() *Handler {
+	return &Handler{repository: repository.Default()}
+}
+
+func (h *Handler) User(id int64) entities.UserDTO {
+	user, _ := h.repository.Find(id)
+	return entities.NewUserDTO(user)
+}
+
+func (h *Handler) Raw(id int64) entities.UserEntity {
+	user, _ := h.repository.Find(id)
+	return user
+}
+
+func (h *Handler) Empty() entities.UserEntity { //go-arch-lint:ignore legacy api, will be removed
+	return entities.UserEntity{}
+}
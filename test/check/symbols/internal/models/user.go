@@ -0,0 +1,14 @@
+package models
+
+type UserEntity struct {
+	ID   int64
+	Name string
+}
+
+type UserDTO struct {
+	Name string
+}
+
+func NewUserDTO(entity UserEntity) UserDTO {
+	return UserDTO{Name: entity.Name}
+}
@@ -0,0 +1,27 @@
+package repository
+
+import "github.com/fe3dback/go-arch-lint/test/check/symbols/internal/models"
+
+type Repository struct {
+	users map[int64]models.UserEntity
+}
+
+func NewRepository() *Repository {
+	return &Repository{users: map[int64]models.UserEntity{}}
+}
+
+func (r *Repository) Find(id int64) (models.UserEntity, bool) {
+	user, ok := r.users[id]
+	return user, ok
+}
+
+func (r *Repository) FindDTO(id int64) models.UserDTO {
+	user, _ := r.Find(id)
+	return models.NewUserDTO(user)
+}
+
+var defaultRepository = NewRepository()
+
+func Default() *Repository {
+	return defaultRepository
+}
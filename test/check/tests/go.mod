@@ -0,0 +1,5 @@
+module github.com/fe3dback/go-arch-lint/test/check/tests
+
+go 1.13
+
+require github.com/stretchr/testify v1.8.4
@@ -0,0 +1,7 @@
+package fakes
+
+import "github.com/fe3dback/go-arch-lint/test/check/tests/internal/repository"
+
+type Repository struct {
+	repository.Repository
+}
@@ -0,0 +1,7 @@
+package repository
+
+type Repository struct{}
+
+func (r *Repository) Find(id int) string {
+	return ""
+}
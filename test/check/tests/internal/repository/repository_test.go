@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/test/check/tests/internal/fakes"
+)
+
+func TestRepository(t *testing.T) {
+	_ = fakes.Repository{} // not allowed, repository don't have tests rules
+}
@@ -0,0 +1,11 @@
+package service
+
+import "github.com/fe3dback/go-arch-lint/test/check/tests/internal/repository"
+
+type Service struct {
+	repo *repository.Repository
+}
+
+func (s *Service) Name(id int) string {
+	return s.repo.Find(id)
+}
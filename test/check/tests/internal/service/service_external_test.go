@@ -0,0 +1,13 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/test/check/tests/internal/fakes"
+	"github.com/fe3dback/go-arch-lint/test/check/tests/internal/service"
+)
+
+func TestServiceExternal(t *testing.T) {
+	_ = fakes.Repository{}
+	_ = service.Service{}
+}
@@ -0,0 +1,13 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/test/check/tests/internal/fakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService(t *testing.T) {
+	fake := fakes.Repository{}
+	assert.Equal(t, "", (&Service{repo: &fake.Repository}).Name(1))
+}
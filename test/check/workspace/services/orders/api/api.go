@@ -0,0 +1,13 @@
+package api
+
+import (
+	"example.com/mono/orders/store"
+	"example.com/mono/shared/log"
+	users "example.com/mono/users/store"
+)
+
+func Handle() {
+	log.Info("orders")
+	store.Save()
+	users.Find()
+}
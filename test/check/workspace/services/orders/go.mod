@@ -0,0 +1,3 @@
+module example.com/mono/orders
+
+go 1.20
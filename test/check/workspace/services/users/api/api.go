@@ -0,0 +1,9 @@
+package api
+
+import (
+	"example.com/mono/users/store"
+)
+
+func Handle() {
+	store.Find()
+}
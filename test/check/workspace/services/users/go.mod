@@ -0,0 +1,3 @@
+module example.com/mono/users
+
+go 1.20
@@ -0,0 +1,9 @@
+package store
+
+import (
+	"example.com/mono/orders/api"
+)
+
+func Find() {
+	api.Handle()
+}
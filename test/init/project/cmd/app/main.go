@@ -0,0 +1,7 @@
+package main
+
+import (
+	_ "github.com/fe3dback/go-arch-lint/test/init/project/internal/api"
+)
+
+func main() {}
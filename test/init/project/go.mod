@@ -0,0 +1,10 @@
+module github.com/fe3dback/go-arch-lint/test/init/project
+
+go 1.20
+
+require (
+	github.com/fe3dback/go-yaml v1.0.0
+	github.com/goccy/go-yaml v1.11.0
+	github.com/lib/pq v1.10.9
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,8 @@
+package api
+
+import (
+	_ "github.com/fe3dback/go-arch-lint/test/init/project/internal"
+	_ "github.com/fe3dback/go-arch-lint/test/init/project/internal/store"
+	_ "github.com/fe3dback/go-arch-lint/test/init/project/internal/store/sql"
+	_ "gopkg.in/yaml.v3"
+)
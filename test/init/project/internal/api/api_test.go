@@ -0,0 +1,5 @@
+package api
+
+import (
+	_ "github.com/stretchr/testify/assert"
+)
@@ -0,0 +1,6 @@
+package sql
+
+import (
+	_ "github.com/fe3dback/go-arch-lint/test/init/project/internal/store"
+	_ "github.com/lib/pq/oid"
+)
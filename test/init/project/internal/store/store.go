@@ -0,0 +1,6 @@
+package store
+
+import (
+	_ "github.com/fe3dback/go-yaml"
+	_ "github.com/goccy/go-yaml/ast"
+)
@@ -0,0 +1,5 @@
+package data
+
+import (
+	_ "github.com/example/unused"
+)